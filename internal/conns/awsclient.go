@@ -22,6 +22,7 @@ import (
 	baselogging "github.com/hashicorp/aws-sdk-go-base/v2/logging"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-provider-aws/internal/dns"
+	tfencryption "github.com/hashicorp/terraform-provider-aws/internal/encryption"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -31,13 +32,16 @@ type AWSClient struct {
 	accountID                 string
 	awsConfig                 *aws.Config
 	clients                   map[string]any
+	defaultEncryptionConfig   *tfencryption.DefaultConfig
 	defaultTagsConfig         *tftags.DefaultConfig
 	endpoints                 map[string]string // From provider configuration.
 	httpClient                *http.Client
+	iamPolicyValidation       bool // From provider configuration.
 	ignoreTagsConfig          *tftags.IgnoreConfig
 	lock                      sync.Mutex
 	logger                    baselogging.Logger
 	partition                 endpoints.Partition
+	permissionPreflight       bool // From provider configuration.
 	region                    string
 	servicePackages           map[string]ServicePackage
 	session                   *session_sdkv1.Session
@@ -71,6 +75,10 @@ func (c *AWSClient) CredentialsProvider(context.Context) aws.CredentialsProvider
 	return c.awsConfig.Credentials
 }
 
+func (c *AWSClient) DefaultEncryptionConfig(context.Context) *tfencryption.DefaultConfig {
+	return c.defaultEncryptionConfig
+}
+
 func (c *AWSClient) DefaultTagsConfig(context.Context) *tftags.DefaultConfig {
 	return c.defaultTagsConfig
 }
@@ -185,6 +193,16 @@ func (c *AWSClient) S3ExpressClient(ctx context.Context) *s3.Client {
 	return c.s3ExpressClient
 }
 
+// IAMPolicyValidation returns the iam_policy_validation provider configuration value.
+func (c *AWSClient) IAMPolicyValidation(context.Context) bool {
+	return c.iamPolicyValidation
+}
+
+// PermissionPreflight returns the permission_preflight provider configuration value.
+func (c *AWSClient) PermissionPreflight(context.Context) bool {
+	return c.permissionPreflight
+}
+
 // S3UsePathStyle returns the s3_force_path_style provider configuration value.
 func (c *AWSClient) S3UsePathStyle(context.Context) bool {
 	return c.s3UsePathStyle
@@ -19,6 +19,7 @@ import (
 	basevalidation "github.com/hashicorp/aws-sdk-go-base/v2/validation"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	tfencryption "github.com/hashicorp/terraform-provider-aws/internal/encryption"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
@@ -32,6 +33,7 @@ type Config struct {
 	AssumeRole                     []awsbase.AssumeRole
 	AssumeRoleWithWebIdentity      *awsbase.AssumeRoleWithWebIdentity
 	CustomCABundle                 string
+	DefaultEncryptionConfig        *tfencryption.DefaultConfig
 	DefaultTagsConfig              *tftags.DefaultConfig
 	EC2MetadataServiceEnableState  imds.ClientEnableState
 	EC2MetadataServiceEndpoint     string
@@ -40,10 +42,14 @@ type Config struct {
 	ForbiddenAccountIds            []string
 	HTTPProxy                      *string
 	HTTPSProxy                     *string
+	IAMPolicyValidation            bool
 	IgnoreTagsConfig               *tftags.IgnoreConfig
 	Insecure                       bool
 	MaxRetries                     int
 	NoProxy                        string
+	Offline                        bool
+	OfflineFixturesPath            string
+	PermissionPreflight            bool
 	Profile                        string
 	Region                         string
 	RetryMode                      aws.RetryMode
@@ -136,6 +142,19 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		awsbaseConfig.StsRegion = c.STSRegion
 	}
 
+	if c.Offline {
+		// No AWS credentials are required, nor any calls made to resolve them.
+		awsbaseConfig.AccessKey = "offline"
+		awsbaseConfig.AssumeRole = nil
+		awsbaseConfig.AssumeRoleWithWebIdentity = nil
+		awsbaseConfig.EC2MetadataServiceEnableState = imds.ClientDisabled
+		awsbaseConfig.Profile = ""
+		awsbaseConfig.SecretKey = "offline"
+		awsbaseConfig.SkipCredsValidation = true
+		awsbaseConfig.SkipRequestingAccountId = true
+		awsbaseConfig.Token = ""
+	}
+
 	// Avoid duplicate calls to STS by enabling SkipCredsValidation for the call to GetAwsConfig
 	// and then restoring the configured value for the call to GetAwsAccountIDAndPartition.
 	skipCredsValidation := awsbaseConfig.SkipCredsValidation
@@ -163,8 +182,20 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	}
 	c.Region = cfg.Region
 
+	if c.Offline {
+		tflog.Info(ctx, "Configuring offline mode", map[string]any{
+			"tf_aws.offline_fixtures_path": c.OfflineFixturesPath,
+		})
+		withOfflineMode(&cfg, c.OfflineFixturesPath)
+	}
+
 	awsbaseConfig.SkipCredsValidation = skipCredsValidation
 
+	if c.Offline {
+		// The AWS SDK v1 session's HTTP client is also used for AWSClient.HTTPClient.
+		awsbaseConfig.HTTPClient = newOfflineUnsupportedHTTPClient()
+	}
+
 	tflog.Debug(ctx, "Creating AWS SDK v1 session")
 	session, awsDiags := awsbasev1.GetSession(ctx, &cfg, &awsbaseConfig)
 
@@ -190,15 +221,22 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		})
 	}
 
-	if accountID == "" && !awsbaseConfig.SkipRequestingAccountId {
-		diags = append(diags, errs.NewWarningDiagnostic(
-			"AWS account ID not found for provider",
-			"See https://registry.terraform.io/providers/hashicorp/aws/latest/docs#skip_requesting_account_id for implications."))
-	}
+	if c.Offline {
+		// The account ID cannot be looked up, but a single allowed account ID is unambiguous.
+		if len(c.AllowedAccountIds) == 1 {
+			accountID = c.AllowedAccountIds[0]
+		}
+	} else {
+		if accountID == "" && !awsbaseConfig.SkipRequestingAccountId {
+			diags = append(diags, errs.NewWarningDiagnostic(
+				"AWS account ID not found for provider",
+				"See https://registry.terraform.io/providers/hashicorp/aws/latest/docs#skip_requesting_account_id for implications."))
+		}
 
-	err := awsbaseConfig.VerifyAccountIDAllowed(accountID)
-	if err != nil {
-		return nil, sdkdiag.AppendErrorf(diags, "%s", err.Error())
+		err := awsbaseConfig.VerifyAccountIDAllowed(accountID)
+		if err != nil {
+			return nil, sdkdiag.AppendErrorf(diags, "%s", err.Error())
+		}
 	}
 
 	for _, partition := range endpoints.DefaultPartitions() {
@@ -208,6 +246,7 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	}
 
 	client.accountID = accountID
+	client.defaultEncryptionConfig = c.DefaultEncryptionConfig
 	client.defaultTagsConfig = c.DefaultTagsConfig
 	client.ignoreTagsConfig = c.IgnoreTagsConfig
 	client.region = c.Region
@@ -218,7 +257,9 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	client.awsConfig = &cfg
 	client.clients = make(map[string]any, 0)
 	client.endpoints = c.Endpoints
+	client.iamPolicyValidation = c.IAMPolicyValidation
 	client.logger = logger
+	client.permissionPreflight = c.PermissionPreflight
 	client.s3UsePathStyle = c.S3UsePathStyle
 	client.s3USEast1RegionalEndpoint = c.S3USEast1RegionalEndpoint
 	client.stsRegion = c.STSRegion
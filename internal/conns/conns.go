@@ -19,6 +19,13 @@ type ServicePackage interface {
 	ServicePackageName() string
 }
 
+// ServicePackageWithActions is an interface that extends ServicePackage with actions.
+// Actions are imperative operations that are invoked by Terraform but are not part of the Terraform state.
+type ServicePackageWithActions interface {
+	ServicePackage
+	Actions(context.Context) []*types.ServicePackageAction
+}
+
 // ServicePackageWithEphemeralResources is an interface that extends ServicePackage with ephemeral resources.
 // Ephemeral resources are resources that are not part of the Terraform state, but are used to create other resources.
 type ServicePackageWithEphemeralResources interface {
@@ -36,12 +43,18 @@ var (
 
 // InContext represents the resource information kept in Context.
 type InContext struct {
+	isAction            bool   // Action?
 	isDataSource        bool   // Data source?
 	isEphemeralResource bool   // Ephemeral resource?
 	resourceName        string // Friendly resource name, e.g. "Subnet"
 	servicePackageName  string // Canonical name defined as a constant in names package
 }
 
+// IsAction returns true if the resource is an action.
+func (c *InContext) IsAction() bool {
+	return c.isAction
+}
+
 // IsDataSource returns true if the resource is a data source.
 func (c *InContext) IsDataSource() bool {
 	return c.isDataSource
@@ -62,6 +75,16 @@ func (c *InContext) ServicePackageName() string {
 	return c.servicePackageName
 }
 
+func NewActionContext(ctx context.Context, servicePackageName, resourceName string) context.Context {
+	v := InContext{
+		isAction:           true,
+		resourceName:       resourceName,
+		servicePackageName: servicePackageName,
+	}
+
+	return context.WithValue(ctx, contextKey, &v)
+}
+
 func NewDataSourceContext(ctx context.Context, servicePackageName, resourceName string) context.Context {
 	v := InContext{
 		isDataSource:       true,
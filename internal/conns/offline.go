@@ -0,0 +1,255 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/smithy-go/middleware"
+)
+
+// In offline mode AWS API requests are answered by an in-process stub from fixture files instead of the network.
+//
+// Fixtures are JSON files named <fixtures path>/<service>/<operation>.json, where <service> is the
+// lower-cased AWS SDK for Go v2 service ID with spaces removed (e.g. "ec2", "lambda", "resourcegroupstaggingapi")
+// and <operation> is the API operation name (e.g. "DescribeVpcs").
+// Each file contains a list of interactions. The first interaction whose (optional) "params" are a subset of the
+// operation's input parameters is used to answer the request. The response "body" is the raw wire-format response
+// body, as captured in a VCR cassette.
+//
+//	[
+//	  {
+//	    "params": {"FunctionName": "example"},
+//	    "response": {
+//	      "status_code": 200,
+//	      "headers": {"Content-Type": "application/json"},
+//	      "body": "{\"Configuration\": {...}}"
+//	    }
+//	  }
+//	]
+//
+// Only read-only operations are supported.
+
+const (
+	offlineFixtureFileExtension = ".json"
+)
+
+var (
+	// offlineReadOnlyOperationPrefixes are the operation name prefixes that identify read-only operations.
+	offlineReadOnlyOperationPrefixes = []string{
+		"BatchGet",
+		"Describe",
+		"Get",
+		"Head",
+		"List",
+		"Lookup",
+		"Query",
+		"Scan",
+		"Search",
+	}
+)
+
+type offlineFixture struct {
+	Params   map[string]any         `json:"params,omitempty"`
+	Response offlineFixtureResponse `json:"response"`
+}
+
+type offlineFixtureResponse struct {
+	Body       string            `json:"body"`
+	Headers    map[string]string `json:"headers,omitempty"`
+	StatusCode int               `json:"status_code,omitempty"`
+}
+
+type offlineOperationKey struct{}
+
+// offlineOperation identifies an AWS API operation invocation.
+type offlineOperation struct {
+	name      string
+	params    any
+	serviceID string
+}
+
+// withOfflineMode configures an AWS SDK for Go v2 configuration to answer all API requests from fixtures.
+func withOfflineMode(cfg *aws.Config, fixturesPath string) {
+	cfg.APIOptions = append(cfg.APIOptions, addOfflineOperationMiddleware)
+	cfg.HTTPClient = &offlineHTTPClient{
+		fixturesPath: fixturesPath,
+	}
+	// Errors are returned for requests without fixtures; never retry them.
+	cfg.Retryer = func() aws.Retryer {
+		return aws.NopRetryer{}
+	}
+}
+
+// newOfflineUnsupportedHTTPClient returns an HTTP client that fails all requests.
+// Requests made with the AWS SDK for Go v1 or directly over HTTP can't be answered from fixtures.
+func newOfflineUnsupportedHTTPClient() *http.Client {
+	return &http.Client{
+		Transport: offlineUnsupportedTransport{},
+	}
+}
+
+type offlineUnsupportedTransport struct{}
+
+func (offlineUnsupportedTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	return nil, fmt.Errorf("offline mode: %s %s is not supported, only AWS SDK for Go v2 API requests are answered from fixtures", request.Method, request.URL)
+}
+
+// addOfflineOperationMiddleware records the invoked operation and its input parameters for use by the offline HTTP client.
+func addOfflineOperationMiddleware(stack *middleware.Stack) error {
+	// Must run after the generated service metadata middleware.
+	return stack.Initialize.Add(middleware.InitializeMiddlewareFunc("OfflineOperation", func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+		ctx = middleware.WithStackValue(ctx, offlineOperationKey{}, offlineOperation{
+			name:      awsmiddleware.GetOperationName(ctx),
+			params:    in.Parameters,
+			serviceID: awsmiddleware.GetServiceID(ctx),
+		})
+
+		return next.HandleInitialize(ctx, in)
+	}), middleware.After)
+}
+
+// offlineHTTPClient is an aws.HTTPClient that answers AWS API requests from fixtures.
+type offlineHTTPClient struct {
+	fixturesPath string
+}
+
+func (c *offlineHTTPClient) Do(request *http.Request) (*http.Response, error) {
+	operation, ok := middleware.GetStackValue(request.Context(), offlineOperationKey{}).(offlineOperation)
+	if !ok {
+		return nil, fmt.Errorf("offline mode: unable to determine AWS API operation for %s %s", request.Method, request.URL)
+	}
+
+	if !isReadOnlyOperation(operation.name) {
+		return nil, fmt.Errorf("offline mode: %s %s is not a read-only operation", operation.serviceID, operation.name)
+	}
+
+	fixture, err := c.findFixture(operation)
+
+	if err != nil {
+		return nil, fmt.Errorf("offline mode: %s %s: %w", operation.serviceID, operation.name, err)
+	}
+
+	response := &http.Response{
+		Body:          io.NopCloser(strings.NewReader(fixture.Response.Body)),
+		ContentLength: int64(len(fixture.Response.Body)),
+		Header:        make(http.Header),
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Request:       request,
+		StatusCode:    http.StatusOK,
+	}
+	if v := fixture.Response.StatusCode; v != 0 {
+		response.StatusCode = v
+	}
+	response.Status = fmt.Sprintf("%d %s", response.StatusCode, http.StatusText(response.StatusCode))
+	for k, v := range fixture.Response.Headers {
+		response.Header.Set(k, v)
+	}
+
+	return response, nil
+}
+
+func (c *offlineHTTPClient) findFixture(operation offlineOperation) (*offlineFixture, error) {
+	path := filepath.Join(c.fixturesPath, offlineServiceDirectory(operation.serviceID), operation.name+offlineFixtureFileExtension)
+	b, err := os.ReadFile(path)
+
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("no fixture file (%s)", path)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("reading fixture file (%s): %w", path, err)
+	}
+
+	var fixtures []offlineFixture
+	if err := json.NewDecoder(bytes.NewReader(b)).Decode(&fixtures); err != nil {
+		return nil, fmt.Errorf("decoding fixture file (%s): %w", path, err)
+	}
+
+	params, err := offlineNormalizeParams(operation.params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, fixture := range fixtures {
+		if offlineParamsMatch(fixture.Params, params) {
+			return &fixture, nil
+		}
+	}
+
+	return nil, fmt.Errorf("no fixture in %s matches input parameters", path)
+}
+
+func isReadOnlyOperation(name string) bool {
+	for _, prefix := range offlineReadOnlyOperationPrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// offlineServiceDirectory returns the fixtures directory name for the specified AWS SDK for Go v2 service ID.
+func offlineServiceDirectory(serviceID string) string {
+	return strings.ToLower(strings.ReplaceAll(serviceID, " ", ""))
+}
+
+// offlineNormalizeParams converts operation input parameters to the generic JSON data model.
+func offlineNormalizeParams(params any) (map[string]any, error) {
+	if params == nil {
+		return nil, nil
+	}
+
+	b, err := json.Marshal(params)
+
+	if err != nil {
+		// Inputs with streaming members may not be representable.
+		return nil, nil //nolint:nilerr // Only fixtures without parameters will match.
+	}
+
+	var m map[string]any
+	if err := json.Unmarshal(b, &m); err != nil {
+		return nil, fmt.Errorf("normalizing input parameters: %w", err)
+	}
+
+	return m, nil
+}
+
+// offlineParamsMatch returns whether want is a subset of got.
+func offlineParamsMatch(want, got any) bool {
+	switch want := want.(type) {
+	case map[string]any:
+		got, ok := got.(map[string]any)
+		if !ok {
+			return len(want) == 0
+		}
+
+		for k, v := range want {
+			if !offlineParamsMatch(v, got[k]) {
+				return false
+			}
+		}
+
+		return true
+
+	default:
+		return reflect.DeepEqual(want, got)
+	}
+}
@@ -4,9 +4,15 @@
 package conns
 
 import (
+	tfencryption "github.com/hashicorp/terraform-provider-aws/internal/encryption"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 )
 
+// SetDefaultEncryptionConfig is only intended for use in tests
+func SetDefaultEncryptionConfig(client *AWSClient, d *tfencryption.DefaultConfig) {
+	client.defaultEncryptionConfig = d
+}
+
 // SetDefaultTagsConfig is only intended for use in tests
 func SetDefaultTagsConfig(client *AWSClient, d *tftags.DefaultConfig) {
 	client.defaultTagsConfig = d
@@ -0,0 +1,36 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package encryption
+
+// DefaultConfig contains encryption settings to default across all resources.
+type DefaultConfig struct {
+	// KMSKeyARN is the KMS key used by resources in all services without a service-specific KMS key.
+	KMSKeyARN string
+	// KMSKeyARNs are the service-specific KMS keys, keyed by service package name.
+	KMSKeyARNs map[string]string
+	// RequireEncryption causes resources explicitly configured unencrypted to be rejected.
+	RequireEncryption bool
+}
+
+// GetKMSKeyARN returns the default KMS key ARN for resources in the specified service package, if any.
+func (dc *DefaultConfig) GetKMSKeyARN(servicePackageName string) string {
+	if dc == nil {
+		return ""
+	}
+
+	if v, ok := dc.KMSKeyARNs[servicePackageName]; ok && v != "" {
+		return v
+	}
+
+	return dc.KMSKeyARN
+}
+
+// GetRequireEncryption returns whether resources must be encrypted.
+func (dc *DefaultConfig) GetRequireEncryption() bool {
+	if dc == nil {
+		return false
+	}
+
+	return dc.RequireEncryption
+}
@@ -0,0 +1,68 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package encryption
+
+import (
+	"testing"
+)
+
+func TestDefaultConfigGetKMSKeyARN(t *testing.T) {
+	t.Parallel()
+
+	const (
+		defaultKeyARN = "arn:aws:kms:us-west-2:123456789012:key/default" //lintignore:AWSAT003,AWSAT005
+		sqsKeyARN     = "arn:aws:kms:us-west-2:123456789012:key/sqs"     //lintignore:AWSAT003,AWSAT005
+	)
+
+	testCases := map[string]struct {
+		config             *DefaultConfig
+		servicePackageName string
+		want               string
+	}{
+		"nil config": {
+			servicePackageName: "sqs",
+		},
+		"empty config": {
+			config:             &DefaultConfig{},
+			servicePackageName: "sqs",
+		},
+		"default key": {
+			config: &DefaultConfig{
+				KMSKeyARN: defaultKeyARN,
+			},
+			servicePackageName: "sqs",
+			want:               defaultKeyARN,
+		},
+		"service key": {
+			config: &DefaultConfig{
+				KMSKeyARN: defaultKeyARN,
+				KMSKeyARNs: map[string]string{
+					"sqs": sqsKeyARN,
+				},
+			},
+			servicePackageName: "sqs",
+			want:               sqsKeyARN,
+		},
+		"other service key": {
+			config: &DefaultConfig{
+				KMSKeyARN: defaultKeyARN,
+				KMSKeyARNs: map[string]string{
+					"sqs": sqsKeyARN,
+				},
+			},
+			servicePackageName: "sns",
+			want:               defaultKeyARN,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := testCase.config.GetKMSKeyARN(testCase.servicePackageName), testCase.want; got != want {
+				t.Errorf("GetKMSKeyARN(%q) = %q, want %q", testCase.servicePackageName, got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,105 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build generate
+// +build generate
+
+package main
+
+import (
+	"cmp"
+	_ "embed"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
+)
+
+//go:embed actions.gtpl
+var actionsTmpl string
+
+type resourceDatum struct {
+	TypeName string
+	Create   []string
+	Update   []string
+	Delete   []string
+}
+
+type TemplateData struct {
+	Resources []resourceDatum
+}
+
+func main() {
+	const (
+		filename     = "../../../internal/provider/permission_preflight_actions_gen.go"
+		dataFilename = "../../../internal/provider/permission_preflight_actions.csv"
+	)
+	g := common.NewGenerator()
+
+	resources, err := readResources(dataFilename)
+
+	if err != nil {
+		g.Fatalf("error reading %s: %s", dataFilename, err)
+	}
+
+	td := TemplateData{
+		Resources: resources,
+	}
+
+	g.Infof("Generating %s", strings.TrimPrefix(filename, "../../../"))
+
+	d := g.NewGoFileDestination(filename)
+
+	if err := d.BufferTemplate("actions", actionsTmpl, td); err != nil {
+		g.Fatalf("generating file (%s): %s", filename, err)
+	}
+
+	if err := d.Write(); err != nil {
+		g.Fatalf("generating file (%s): %s", filename, err)
+	}
+}
+
+// readResources reads resource type IAM action data.
+// Each row is "<resource type>,<create actions>,<update actions>,<delete actions>" with actions separated by spaces.
+func readResources(filename string) ([]resourceDatum, error) {
+	rows, err := common.ReadAllCSVData(filename)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var resources []resourceDatum
+
+	for i, row := range rows {
+		if i == 0 || row[0] == "" {
+			// Skip the header row.
+			continue
+		}
+
+		if len(row) != 4 {
+			return nil, fmt.Errorf("row %d: expected 4 fields, got %d", i+1, len(row))
+		}
+
+		resources = append(resources, resourceDatum{
+			TypeName: row[0],
+			Create:   actions(row[1]),
+			Update:   actions(row[2]),
+			Delete:   actions(row[3]),
+		})
+	}
+
+	slices.SortStableFunc(resources, func(a, b resourceDatum) int {
+		return cmp.Compare(a.TypeName, b.TypeName)
+	})
+
+	return resources, nil
+}
+
+func actions(s string) []string {
+	v := strings.Fields(s)
+
+	slices.Sort(v)
+
+	return slices.Compact(v)
+}
@@ -0,0 +1,128 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package inventory
+
+import (
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/zclconf/go-cty/cty"
+)
+
+const (
+	maxResourceNameLength = 64
+)
+
+// writeResources writes an import block and, unless importOnly is set, a resource block for each resource.
+func writeResources(w io.Writer, resources []resourceInstance, importOnly bool) error {
+	f := hclwrite.NewEmptyFile()
+	body := f.Body()
+	resourceNames := make(map[string]bool)
+
+	for i, v := range resources {
+		if i > 0 {
+			body.AppendNewline()
+		}
+
+		name := uniqueResourceName(resourceNames, v.typeName, v.importID)
+
+		block := body.AppendNewBlock("import", nil)
+		block.Body().SetAttributeTraversal("to", hcl.Traversal{
+			hcl.TraverseRoot{Name: v.typeName},
+			hcl.TraverseAttr{Name: name},
+		})
+		block.Body().SetAttributeValue("id", cty.StringVal(v.importID))
+
+		if importOnly {
+			continue
+		}
+
+		body.AppendNewline()
+		block = body.AppendNewBlock("resource", []string{v.typeName, name})
+
+		for _, k := range slices.Sorted(maps.Keys(v.attributes)) {
+			if value, ok := ctyValue(v.attributes[k]); ok {
+				block.Body().SetAttributeValue(k, value)
+			} else {
+				block.Body().AppendUnstructuredTokens(hclwrite.Tokens{
+					{Type: hclsyntax.TokenComment, Bytes: []byte(fmt.Sprintf("# %s = <required>\n", k))},
+				})
+			}
+		}
+	}
+
+	_, err := f.WriteTo(w)
+
+	return err
+}
+
+func ctyValue(v any) (cty.Value, bool) {
+	switch v := v.(type) {
+	case bool:
+		return cty.BoolVal(v), true
+	case float64:
+		return cty.NumberFloatVal(v), true
+	case int:
+		return cty.NumberIntVal(int64(v)), true
+	case int64:
+		return cty.NumberIntVal(v), true
+	case string:
+		return cty.StringVal(v), true
+	default:
+		return cty.NilVal, false
+	}
+}
+
+// uniqueResourceName returns a valid Terraform resource name derived from the import ID,
+// unique amongst the names already returned for the resource type.
+func uniqueResourceName(seen map[string]bool, typeName, importID string) string {
+	base := resourceName(importID)
+	name := base
+
+	// A suffixed name may collide with a name derived from another import ID, e.g. "x_2".
+	for n := 2; seen[typeName+"."+name]; n++ {
+		name = base + "_" + strconv.Itoa(n)
+	}
+
+	seen[typeName+"."+name] = true
+
+	return name
+}
+
+func resourceName(importID string) string {
+	var sb strings.Builder
+
+	// Use the last part of ARNs, URLs and paths.
+	if i := strings.LastIndexAny(importID, ":/"); i >= 0 && i < len(importID)-1 {
+		importID = importID[i+1:]
+	}
+
+	for _, r := range strings.ToLower(importID) {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9', r == '_', r == '-':
+			sb.WriteRune(r)
+		default:
+			sb.WriteRune('_')
+		}
+	}
+
+	name := strings.Trim(sb.String(), "_-")
+
+	if len(name) > maxResourceNameLength {
+		name = name[:maxResourceNameLength]
+	}
+
+	if name == "" || !(name[0] >= 'a' && name[0] <= 'z' || name[0] == '_') {
+		name = "r_" + name
+	}
+
+	return name
+}
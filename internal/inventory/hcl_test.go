@@ -0,0 +1,182 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package inventory
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestResourceName(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		importID string
+		want     string
+	}{
+		"simple": {
+			importID: "my-queue",
+			want:     "my-queue",
+		},
+		"ARN": {
+			importID: "arn:aws:sns:us-west-2:123456789012:MyTopic", //lintignore:AWSAT003,AWSAT005
+			want:     "mytopic",
+		},
+		"URL": {
+			importID: "https://sqs.us-west-2.amazonaws.com/123456789012/queue.fifo", //lintignore:AWSAT003
+			want:     "queue_fifo",
+		},
+		"leading digit": {
+			importID: "0123abc",
+			want:     "r_0123abc",
+		},
+		"invalid characters": {
+			importID: "Name With Spaces!",
+			want:     "name_with_spaces",
+		},
+		"trailing separator": {
+			importID: "path/",
+			want:     "path",
+		},
+		"too long": {
+			importID: strings.Repeat("a", 100),
+			want:     strings.Repeat("a", maxResourceNameLength),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := resourceName(testCase.importID), testCase.want; got != want {
+				t.Errorf("resourceName(%q) = %q, want %q", testCase.importID, got, want)
+			}
+		})
+	}
+}
+
+func TestUniqueResourceName(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		importIDs []string
+		want      []string
+	}{
+		"unique": {
+			importIDs: []string{"a", "b"},
+			want:      []string{"a", "b"},
+		},
+		"duplicate": {
+			importIDs: []string{"x", "X", "x"},
+			want:      []string{"x", "x_2", "x_3"},
+		},
+		"suffix collision": {
+			importIDs: []string{"x_2", "x", "x"},
+			want:      []string{"x_2", "x", "x_3"},
+		},
+		"suffix collision after duplicate": {
+			importIDs: []string{"x", "x", "x_2"},
+			want:      []string{"x", "x_2", "x_2_2"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			seen := make(map[string]bool)
+			var got []string
+			for _, importID := range testCase.importIDs {
+				got = append(got, uniqueResourceName(seen, "aws_example_thing", importID))
+			}
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestWriteResources(t *testing.T) {
+	t.Parallel()
+
+	resources := []resourceInstance{
+		{
+			attributes: map[string]any{
+				names.AttrName: "example",
+				"policy":       nil,
+			},
+			importID: "example",
+			typeName: "aws_example_thing",
+		},
+		{
+			attributes: map[string]any{
+				names.AttrName: "Example",
+				"policy":       nil,
+			},
+			importID: "Example",
+			typeName: "aws_example_thing",
+		},
+	}
+
+	testCases := map[string]struct {
+		importOnly bool
+		want       string
+	}{
+		"import and resource": {
+			want: `import {
+  to = aws_example_thing.example
+  id = "example"
+}
+
+resource "aws_example_thing" "example" {
+  name = "example"
+  # policy = <required>
+}
+
+import {
+  to = aws_example_thing.example_2
+  id = "Example"
+}
+
+resource "aws_example_thing" "example_2" {
+  name = "Example"
+  # policy = <required>
+}
+`,
+		},
+		"import only": {
+			importOnly: true,
+			want: `import {
+  to = aws_example_thing.example
+  id = "example"
+}
+
+import {
+  to = aws_example_thing.example_2
+  id = "Example"
+}
+`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var sb strings.Builder
+
+			if err := writeResources(&sb, resources, testCase.importOnly); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(sb.String(), testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
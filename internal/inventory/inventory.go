@@ -0,0 +1,228 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package inventory implements the provider binary's "inventory" command,
+// which enumerates existing AWS resources using the service packages' sweeper listing functions
+// and emits Terraform import blocks and a minimal resource configuration for each resource.
+package inventory
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/version"
+)
+
+const (
+	CommandName = "inventory"
+)
+
+type options struct {
+	importOnly    bool
+	output        string
+	profile       string
+	region        string
+	resourceTypes []string
+	services      []string
+}
+
+// Run runs the inventory command with the specified command line arguments.
+func Run(ctx context.Context, args []string, stdout, stderr io.Writer) error {
+	opts, err := parseArgs(args, stderr)
+
+	if err != nil {
+		return err
+	}
+
+	registerSweepers()
+
+	servicePackageMap := make(map[string]conns.ServicePackage)
+	for _, sp := range servicePackages(ctx) {
+		servicePackageMap[sp.ServicePackageName()] = sp
+	}
+
+	meta := new(conns.AWSClient)
+	meta.SetServicePackages(ctx, servicePackageMap)
+
+	config := conns.Config{
+		MaxRetries:       25,
+		Profile:          opts.profile,
+		Region:           opts.region,
+		SuppressDebugLog: true,
+		TerraformVersion: "inventory/" + version.ProviderVersion,
+	}
+	client, diags := config.ConfigureProvider(ctx, meta)
+
+	if diags.HasError() {
+		return fmt.Errorf("configuring AWS client: %w", sdkdiag.DiagnosticsError(diags))
+	}
+
+	var errs []error
+	var resources []resourceInstance
+
+	for _, name := range opts.services {
+		sp, ok := servicePackageMap[name]
+
+		if !ok {
+			errs = append(errs, fmt.Errorf("unknown service package: %s", name))
+			continue
+		}
+
+		for _, typeName := range resourceTypeNames(ctx, sp) {
+			if len(opts.resourceTypes) > 0 && !slices.Contains(opts.resourceTypes, typeName) {
+				continue
+			}
+
+			lister, ok := sweep.Lister(typeName)
+
+			if !ok {
+				fmt.Fprintf(stderr, "Skipping %s: no sweeper listing function\n", typeName)
+				continue
+			}
+
+			v, err := listResources(ctx, client, typeName, lister)
+
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+
+			fmt.Fprintf(stderr, "Found %d %s\n", len(v), typeName)
+			resources = append(resources, v...)
+		}
+	}
+
+	w := stdout
+
+	if opts.output != "" {
+		f, err := os.Create(opts.output)
+
+		if err != nil {
+			return errors.Join(append(errs, err)...)
+		}
+
+		defer f.Close()
+
+		w = f
+	}
+
+	if err := writeResources(w, resources, opts.importOnly); err != nil {
+		errs = append(errs, err)
+	}
+
+	return errors.Join(errs...)
+}
+
+func parseArgs(args []string, stderr io.Writer) (*options, error) {
+	fs := flag.NewFlagSet(CommandName, flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "Usage: terraform-provider-aws %s -services=<service packages> [options]\n\n", CommandName)
+		fmt.Fprintln(fs.Output(), "Emits Terraform import blocks and resource configuration skeletons for existing AWS resources.")
+		fmt.Fprintln(fs.Output(), "Credentials are resolved in the same way as for the provider, e.g. from environment variables or shared configuration files.")
+		fmt.Fprintln(fs.Output())
+		fs.PrintDefaults()
+	}
+
+	importOnly := fs.Bool("import-only", false, "Emit only import blocks, e.g. for use with 'terraform plan -generate-config-out'.")
+	output := fs.String("out", "", "File to write to. Defaults to standard output.")
+	profile := fs.String("profile", "", "AWS shared configuration profile.")
+	region := fs.String("region", "", "AWS Region. Defaults to the AWS_REGION environment variable or the profile's Region.")
+	resourceTypes := fs.String("resource-types", "", "Comma-separated resource types to inventory, e.g. 'aws_sqs_queue'. Defaults to all resource types in the service packages.")
+	services := fs.String("services", "", "Comma-separated service packages to inventory, e.g. 'lambda,sqs'. Required.")
+
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+
+	if *services == "" {
+		fs.Usage()
+		return nil, errors.New("-services is required")
+	}
+
+	return &options{
+		importOnly:    *importOnly,
+		output:        *output,
+		profile:       *profile,
+		region:        *region,
+		resourceTypes: splitList(*resourceTypes),
+		services:      splitList(*services),
+	}, nil
+}
+
+func splitList(s string) []string {
+	return tfslices.Filter(tfslices.ApplyToAll(strings.Split(s, ","), strings.TrimSpace), func(v string) bool {
+		return v != ""
+	})
+}
+
+// resourceTypeNames returns the sorted resource type names implemented by the service package.
+func resourceTypeNames(ctx context.Context, sp conns.ServicePackage) []string {
+	var typeNames []string
+
+	for _, v := range sp.FrameworkResources(ctx) {
+		typeNames = append(typeNames, v.TypeName)
+	}
+	for _, v := range sp.SDKResources(ctx) {
+		typeNames = append(typeNames, v.TypeName)
+	}
+
+	slices.Sort(typeNames)
+
+	return typeNames
+}
+
+// resourceInstance is an existing resource to be imported.
+type resourceInstance struct {
+	attributes map[string]any
+	importID   string
+	typeName   string
+}
+
+func listResources(ctx context.Context, client *conns.AWSClient, typeName string, lister sweep.SweeperFn) ([]resourceInstance, error) {
+	sweepables, err := lister(ctx, client)
+
+	if err != nil {
+		return nil, fmt.Errorf("listing %s: %w", typeName, err)
+	}
+
+	var resources []resourceInstance
+
+	for _, v := range sweepables {
+		v, ok := v.(sweep.Importable)
+
+		if !ok {
+			return nil, fmt.Errorf("listing %s: sweeper does not support import", typeName)
+		}
+
+		importID := v.ImportID(ctx)
+
+		if importID == "" {
+			continue
+		}
+
+		attributes, err := v.RequiredAttributes(ctx)
+
+		if err != nil {
+			return nil, fmt.Errorf("listing %s (%s): %w", typeName, importID, err)
+		}
+
+		resources = append(resources, resourceInstance{
+			attributes: attributes,
+			importID:   importID,
+			typeName:   typeName,
+		})
+	}
+
+	return resources, nil
+}
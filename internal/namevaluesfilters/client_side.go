@@ -0,0 +1,148 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package namevaluesfilters
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"github.com/jmespath/go-jmespath"
+)
+
+// Matcher evaluates NameValuesFilters client-side, for APIs that do not support server-side filtering.
+// Each filter name is a JMESPath expression (e.g. "Runtime", "Tags.Environment" or "Settings[?Name=='containerInsights'].Value")
+// evaluated against the AWS API object, using the object's Go field names.
+// An object matches a filter if the expression's result, or any element of a list result, equals any of the filter's values.
+// An object must match all filters to be included.
+type Matcher struct {
+	filters []compiledFilter
+}
+
+type compiledFilter struct {
+	expression *jmespath.JMESPath
+	values     []string
+}
+
+// Matcher returns a client-side Matcher for the filters.
+// An error is returned if any filter name is not a valid JMESPath expression.
+func (filters NameValuesFilters) Matcher() (*Matcher, error) {
+	m := filters.Map()
+	matcher := &Matcher{
+		filters: make([]compiledFilter, 0, len(m)),
+	}
+
+	for name, values := range m {
+		expression, err := jmespath.Compile(name)
+
+		if err != nil {
+			return nil, fmt.Errorf("invalid filter name (%s): %w", name, err)
+		}
+
+		matcher.filters = append(matcher.filters, compiledFilter{
+			expression: expression,
+			values:     values,
+		})
+	}
+
+	return matcher, nil
+}
+
+// Match reports whether v matches all filters.
+func (m *Matcher) Match(v any) (bool, error) {
+	if len(m.filters) == 0 {
+		return true, nil
+	}
+
+	// Normalize the AWS API object to the generic JSON data model so that pointers,
+	// enumerations and timestamps compare as plain values.
+	data, err := toJSONValue(v)
+
+	if err != nil {
+		return false, err
+	}
+
+	for _, filter := range m.filters {
+		result, err := filter.expression.Search(data)
+
+		if err != nil {
+			return false, err
+		}
+
+		if !slices.ContainsFunc(scalarStrings(result), func(s string) bool {
+			return slices.Contains(filter.values, s)
+		}) {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// Filter returns the elements of s that match all filters.
+func Filter[S ~[]E, E any](filters NameValuesFilters, s S) (S, error) {
+	matcher, err := filters.Matcher()
+
+	if err != nil {
+		return nil, err
+	}
+
+	var output S
+
+	for _, v := range s {
+		ok, err := matcher.Match(v)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if ok {
+			output = append(output, v)
+		}
+	}
+
+	return output, nil
+}
+
+func toJSONValue(v any) (any, error) {
+	b, err := json.Marshal(v)
+
+	if err != nil {
+		return nil, fmt.Errorf("marshaling %T: %w", v, err)
+	}
+
+	var data any
+	if err := json.Unmarshal(b, &data); err != nil {
+		return nil, fmt.Errorf("unmarshaling %T: %w", v, err)
+	}
+
+	return data, nil
+}
+
+// scalarStrings returns the string representations of a JMESPath search result.
+// Lists are flattened one level; objects and nulls never match.
+func scalarStrings(v any) []string {
+	switch v := v.(type) {
+	case string:
+		return []string{v}
+	case bool:
+		return []string{strconv.FormatBool(v)}
+	case float64:
+		return []string{strconv.FormatFloat(v, 'f', -1, 64)}
+	case []any:
+		var s []string
+		for _, v := range v {
+			switch v.(type) {
+			case []any:
+				continue
+			default:
+				s = append(s, scalarStrings(v)...)
+			}
+		}
+		return s
+	default:
+		return nil
+	}
+}
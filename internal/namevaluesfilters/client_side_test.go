@@ -0,0 +1,140 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package namevaluesfilters_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-provider-aws/internal/namevaluesfilters"
+)
+
+type testClientSideSetting struct {
+	Name  *string
+	Value *string
+}
+
+type testClientSideObject struct {
+	Name     *string
+	Memory   *int32
+	Enabled  bool
+	Settings []testClientSideSetting
+	Layers   []string
+}
+
+func TestFilter(t *testing.T) {
+	t.Parallel()
+
+	objects := []testClientSideObject{
+		{
+			Name:    aws.String("one"),
+			Memory:  aws.Int32(128),
+			Enabled: true,
+			Settings: []testClientSideSetting{
+				{Name: aws.String("containerInsights"), Value: aws.String("enabled")},
+			},
+			Layers: []string{"layer1", "layer2"},
+		},
+		{
+			Name:    aws.String("two"),
+			Memory:  aws.Int32(256),
+			Enabled: false,
+			Settings: []testClientSideSetting{
+				{Name: aws.String("containerInsights"), Value: aws.String("disabled")},
+			},
+		},
+		{
+			Name: aws.String("three"),
+		},
+	}
+
+	testCases := []struct {
+		name      string
+		filters   namevaluesfilters.NameValuesFilters
+		want      []string
+		wantError bool
+	}{
+		{
+			name:    "no filters",
+			filters: namevaluesfilters.New(map[string][]string{}),
+			want:    []string{"one", "two", "three"},
+		},
+		{
+			name: "string",
+			filters: namevaluesfilters.New(map[string][]string{
+				"Name": {"two", "three"},
+			}),
+			want: []string{"two", "three"},
+		},
+		{
+			name: "number",
+			filters: namevaluesfilters.New(map[string][]string{
+				"Memory": {"128"},
+			}),
+			want: []string{"one"},
+		},
+		{
+			name: "bool",
+			filters: namevaluesfilters.New(map[string][]string{
+				"Enabled": {"false"},
+			}),
+			want: []string{"two", "three"},
+		},
+		{
+			name: "list",
+			filters: namevaluesfilters.New(map[string][]string{
+				"Layers": {"layer2"},
+			}),
+			want: []string{"one"},
+		},
+		{
+			name: "selector",
+			filters: namevaluesfilters.New(map[string][]string{
+				"Settings[?Name=='containerInsights'].Value": {"enabled"},
+			}),
+			want: []string{"one"},
+		},
+		{
+			name: "all filters must match",
+			filters: namevaluesfilters.New(map[string][]string{
+				"Name":    {"one", "two"},
+				"Enabled": {"false"},
+			}),
+			want: []string{"two"},
+		},
+		{
+			name: "invalid expression",
+			filters: namevaluesfilters.New(map[string][]string{
+				"Settings[?": {"enabled"},
+			}),
+			wantError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			output, err := namevaluesfilters.Filter(testCase.filters, objects)
+
+			if got, want := err != nil, testCase.wantError; got != want {
+				t.Fatalf("Filter() err %t, want %t (%v)", got, want, err)
+			}
+
+			if err != nil {
+				return
+			}
+
+			var got []string
+			for _, v := range output {
+				got = append(got, aws.ToString(v.Name))
+			}
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,72 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package namevaluesfilters
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// FilterModel represents a single configured filter in a Plugin Framework data source.
+type FilterModel struct {
+	Name   types.String        `tfsdk:"name"`
+	Values fwtypes.SetOfString `tfsdk:"values"`
+}
+
+// Filters is the Plugin Framework value type of the block returned by FiltersBlock.
+type Filters = fwtypes.SetNestedObjectValueOf[FilterModel]
+
+// FiltersBlock is the Plugin Framework variant of Schema.
+// It is conventional for a block of this type to be included as a top-level block called "filter"
+// and for the corresponding data source model field to be of type Filters.
+func FiltersBlock(ctx context.Context) schema.SetNestedBlock {
+	return schema.SetNestedBlock{
+		CustomType: fwtypes.NewSetNestedObjectTypeOf[FilterModel](ctx),
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				names.AttrName: schema.StringAttribute{
+					Required: true,
+				},
+				names.AttrValues: schema.SetAttribute{
+					CustomType:  fwtypes.SetOfStringType,
+					ElementType: types.StringType,
+					Required:    true,
+				},
+			},
+		},
+	}
+}
+
+// NewFromFramework creates NameValuesFilters from a Plugin Framework value of the block returned by FiltersBlock.
+func NewFromFramework(ctx context.Context, v Filters) (NameValuesFilters, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	filters := make(NameValuesFilters)
+
+	if v.IsNull() || v.IsUnknown() {
+		return filters, diags
+	}
+
+	data, d := v.ToSlice(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return filters, diags
+	}
+
+	for _, data := range data {
+		if data.Name.IsNull() || data.Name.IsUnknown() {
+			continue
+		}
+
+		name := data.Name.ValueString()
+		filters[name] = append(filters[name], fwflex.ExpandFrameworkStringValueSet(ctx, data.Values)...)
+	}
+
+	return filters, diags
+}
@@ -0,0 +1,277 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// defaultEncryptionResourceSpec describes how the provider's default_encryption configuration applies to a resource type.
+type defaultEncryptionResourceSpec struct {
+	// block is the name of the single-item configuration block containing the attributes below.
+	// If empty, the attributes are top-level.
+	block string
+	// kmsKeyAttribute is the attribute set to the default KMS key when not configured.
+	kmsKeyAttribute string
+	// encryptedAttribute is the Boolean attribute that, when configured false without a KMS key, disables encryption.
+	encryptedAttribute string
+	// setEncrypted is whether encryptedAttribute is set to true when the default KMS key is used.
+	setEncrypted bool
+	// encryptedWithoutKMSKey is whether encryptedAttribute configured true enables encryption that doesn't use a KMS key,
+	// for example SSE-SQS. The default KMS key isn't used then.
+	encryptedWithoutKMSKey bool
+	// blockValue, if set, returns the value of block that uses the default KMS key.
+	// block is set only when not configured, and kmsKeyAttribute and encryptedAttribute are unused.
+	blockValue func(kmsKeyARN string) []any
+}
+
+// defaultEncryptionResourceSpecs are the resource types to which the provider's default_encryption configuration applies.
+var defaultEncryptionResourceSpecs = map[string]defaultEncryptionResourceSpec{
+	"aws_cloudwatch_log_group": {
+		kmsKeyAttribute: names.AttrKMSKeyID,
+	},
+	"aws_db_instance": {
+		kmsKeyAttribute:    names.AttrKMSKeyID,
+		encryptedAttribute: names.AttrStorageEncrypted,
+		setEncrypted:       true,
+	},
+	"aws_dynamodb_table": {
+		block:              "server_side_encryption",
+		kmsKeyAttribute:    names.AttrKMSKeyARN,
+		encryptedAttribute: names.AttrEnabled,
+		setEncrypted:       true,
+	},
+	"aws_ebs_volume": {
+		kmsKeyAttribute:    names.AttrKMSKeyID,
+		encryptedAttribute: names.AttrEncrypted,
+		setEncrypted:       true,
+	},
+	"aws_rds_cluster": {
+		kmsKeyAttribute:    names.AttrKMSKeyID,
+		encryptedAttribute: names.AttrStorageEncrypted,
+		setEncrypted:       true,
+	},
+	"aws_s3_bucket": {
+		block: "server_side_encryption_configuration",
+		blockValue: func(kmsKeyARN string) []any {
+			return []any{map[string]any{
+				names.AttrRule: []any{map[string]any{
+					"apply_server_side_encryption_by_default": []any{map[string]any{
+						"kms_master_key_id": kmsKeyARN,
+						"sse_algorithm":     string(s3types.ServerSideEncryptionAwsKms),
+					}},
+				}},
+			}}
+		},
+	},
+	"aws_secretsmanager_secret": {
+		kmsKeyAttribute: names.AttrKMSKeyID,
+	},
+	"aws_sns_topic": {
+		kmsKeyAttribute: "kms_master_key_id",
+	},
+	"aws_sqs_queue": {
+		kmsKeyAttribute:        "kms_master_key_id",
+		encryptedAttribute:     "sqs_managed_sse_enabled",
+		encryptedWithoutKMSKey: true,
+	},
+}
+
+// configValue returns the configured value of the specified attribute, or null if not configured.
+func (spec defaultEncryptionResourceSpec) configValue(rawConfig cty.Value, attr string) cty.Value {
+	v := rawConfig
+
+	if !v.IsKnown() || v.IsNull() {
+		return cty.NullVal(cty.DynamicPseudoType)
+	}
+
+	if spec.block != "" {
+		v = v.GetAttr(spec.block)
+
+		if !v.IsKnown() || v.IsNull() || v.LengthInt() == 0 {
+			return cty.NullVal(cty.DynamicPseudoType)
+		}
+
+		v = v.Index(cty.NumberIntVal(0))
+	}
+
+	return v.GetAttr(attr)
+}
+
+// isKMSKeyConfigured returns whether the KMS key is configured.
+// For resource types whose block is set from the default KMS key, returns whether the block is configured.
+func (spec defaultEncryptionResourceSpec) isKMSKeyConfigured(rawConfig cty.Value) bool {
+	if spec.blockValue != nil {
+		if !rawConfig.IsKnown() || rawConfig.IsNull() {
+			return false
+		}
+
+		v := rawConfig.GetAttr(spec.block)
+
+		return !v.IsKnown() || (!v.IsNull() && v.LengthInt() > 0)
+	}
+
+	return !spec.configValue(rawConfig, spec.kmsKeyAttribute).IsNull()
+}
+
+// isUnencrypted returns whether the configuration explicitly disables encryption.
+func (spec defaultEncryptionResourceSpec) isUnencrypted(rawConfig cty.Value) bool {
+	if spec.encryptedAttribute == "" || spec.isKMSKeyConfigured(rawConfig) {
+		return false
+	}
+
+	v := spec.configValue(rawConfig, spec.encryptedAttribute)
+
+	return v.IsKnown() && !v.IsNull() && v.False()
+}
+
+// isEncryptedWithoutKMSKey returns whether the configuration explicitly enables encryption that doesn't use a KMS key.
+func (spec defaultEncryptionResourceSpec) isEncryptedWithoutKMSKey(rawConfig cty.Value) bool {
+	if !spec.encryptedWithoutKMSKey || spec.isKMSKeyConfigured(rawConfig) {
+		return false
+	}
+
+	v := spec.configValue(rawConfig, spec.encryptedAttribute)
+
+	return v.IsKnown() && !v.IsNull() && v.True()
+}
+
+// defaultEncryption returns a CustomizeDiffFunc that plans the provider's default KMS key for new resources
+// that don't configure a KMS key and aren't configured unencrypted.
+// computedAttrs are the top-level attributes made Computed so that the default KMS key can be planned.
+// Unless set from the default KMS key, they are planned as if not Computed: null on create and removed when no longer configured.
+func defaultEncryption(servicePackageName string, spec defaultEncryptionResourceSpec, computedAttrs []string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+		rawConfig := d.GetRawConfig()
+		if !rawConfig.IsKnown() || rawConfig.IsNull() {
+			return nil
+		}
+
+		var kmsKeyARN string
+		if c, ok := meta.(*conns.AWSClient); ok {
+			kmsKeyARN = c.DefaultEncryptionConfig(ctx).GetKMSKeyARN(servicePackageName)
+		}
+
+		if d.Id() == "" {
+			if kmsKeyARN != "" && !spec.isKMSKeyConfigured(rawConfig) && !spec.isUnencrypted(rawConfig) && !spec.isEncryptedWithoutKMSKey(rawConfig) {
+				return spec.setDefaultKMSKey(d, kmsKeyARN)
+			}
+
+			for _, attr := range computedAttrs {
+				if spec.configValue(rawConfig, attr).IsNull() {
+					if err := d.Clear(attr); err != nil {
+						return err
+					}
+				}
+			}
+
+			return nil
+		}
+
+		if kmsKeyARN != "" && !spec.isKMSKeyConfigured(rawConfig) {
+			if o, _ := d.GetChange(spec.kmsKeyAttribute); o == kmsKeyARN {
+				return nil
+			}
+		}
+
+		for _, attr := range computedAttrs {
+			if !spec.configValue(rawConfig, attr).IsNull() {
+				continue
+			}
+
+			var err error
+			switch v := d.Get(attr).(type) {
+			case string:
+				// The removed value is planned as known after apply.
+				if v != "" {
+					err = d.SetNew(attr, "")
+				}
+			case bool:
+				if v {
+					err = d.SetNew(attr, false)
+				}
+			}
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
+// setDefaultKMSKey plans the use of the specified default KMS key.
+func (spec defaultEncryptionResourceSpec) setDefaultKMSKey(d *schema.ResourceDiff, kmsKeyARN string) error {
+	if spec.blockValue != nil {
+		if err := d.SetNew(spec.block, spec.blockValue(kmsKeyARN)); err != nil {
+			return fmt.Errorf("setting %s: %w", spec.block, err)
+		}
+
+		return nil
+	}
+
+	if spec.block == "" {
+		if err := d.SetNew(spec.kmsKeyAttribute, kmsKeyARN); err != nil {
+			return fmt.Errorf("setting %s: %w", spec.kmsKeyAttribute, err)
+		}
+
+		if spec.setEncrypted {
+			if err := d.SetNew(spec.encryptedAttribute, true); err != nil {
+				return fmt.Errorf("setting %s: %w", spec.encryptedAttribute, err)
+			}
+		}
+
+		return nil
+	}
+
+	tfMap := make(map[string]any)
+	if v, ok := d.Get(spec.block).([]any); ok && len(v) > 0 && v[0] != nil {
+		maps.Copy(tfMap, v[0].(map[string]any))
+	}
+
+	tfMap[spec.kmsKeyAttribute] = kmsKeyARN
+	if spec.setEncrypted {
+		tfMap[spec.encryptedAttribute] = true
+	}
+
+	if err := d.SetNew(spec.block, []any{tfMap}); err != nil {
+		return fmt.Errorf("setting %s: %w", spec.block, err)
+	}
+
+	return nil
+}
+
+// requireEncryption returns a CustomizeDiffFunc that fails the plan for resources configured unencrypted
+// if the provider's default_encryption configuration requires encryption.
+func requireEncryption(spec defaultEncryptionResourceSpec) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+		c, ok := meta.(*conns.AWSClient)
+		if !ok || !c.DefaultEncryptionConfig(ctx).GetRequireEncryption() {
+			return nil
+		}
+
+		if rawConfig := d.GetRawConfig(); spec.isUnencrypted(rawConfig) {
+			return fmt.Errorf("encryption is required by the provider's default_encryption configuration: `%s` must not be false", spec.attributePath(spec.encryptedAttribute))
+		}
+
+		return nil
+	}
+}
+
+// attributePath returns the path of the specified attribute for use in messages.
+func (spec defaultEncryptionResourceSpec) attributePath(attr string) string {
+	if spec.block != "" {
+		return spec.block + "." + attr
+	}
+
+	return attr
+}
@@ -0,0 +1,431 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	terraformsdk "github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfencryption "github.com/hashicorp/terraform-provider-aws/internal/encryption"
+)
+
+func TestDefaultEncryptionResourceSpec(t *testing.T) {
+	t.Parallel()
+
+	topLevelSpec := defaultEncryptionResourceSpec{
+		kmsKeyAttribute:    "kms_key_id",
+		encryptedAttribute: "encrypted",
+		setEncrypted:       true,
+	}
+	topLevelConfig := func(kmsKeyID cty.Value, encrypted cty.Value) cty.Value {
+		return cty.ObjectVal(map[string]cty.Value{
+			"kms_key_id": kmsKeyID,
+			"encrypted":  encrypted,
+		})
+	}
+	blockSpec := defaultEncryptionResourceSpec{
+		block:              "server_side_encryption",
+		kmsKeyAttribute:    "kms_key_arn",
+		encryptedAttribute: "enabled",
+		setEncrypted:       true,
+	}
+	blockType := cty.Object(map[string]cty.Type{
+		"kms_key_arn": cty.String,
+		"enabled":     cty.Bool,
+	})
+	blockConfig := func(blocks ...cty.Value) cty.Value {
+		v := cty.ListValEmpty(blockType)
+		if len(blocks) > 0 {
+			v = cty.ListVal(blocks)
+		}
+		return cty.ObjectVal(map[string]cty.Value{
+			"server_side_encryption": v,
+		})
+	}
+
+	testCases := map[string]struct {
+		spec                 defaultEncryptionResourceSpec
+		rawConfig            cty.Value
+		wantKMSKeyConfigured bool
+		wantUnencrypted      bool
+	}{
+		"top-level not configured": {
+			spec:      topLevelSpec,
+			rawConfig: topLevelConfig(cty.NullVal(cty.String), cty.NullVal(cty.Bool)),
+		},
+		"top-level KMS key configured": {
+			spec:                 topLevelSpec,
+			rawConfig:            topLevelConfig(cty.StringVal("arn:aws:kms:us-west-2:123456789012:key/example"), cty.True), //lintignore:AWSAT003,AWSAT005
+			wantKMSKeyConfigured: true,
+		},
+		"top-level unencrypted": {
+			spec:            topLevelSpec,
+			rawConfig:       topLevelConfig(cty.NullVal(cty.String), cty.False),
+			wantUnencrypted: true,
+		},
+		"top-level encrypted": {
+			spec:      topLevelSpec,
+			rawConfig: topLevelConfig(cty.NullVal(cty.String), cty.True),
+		},
+		"top-level unknown": {
+			spec:      topLevelSpec,
+			rawConfig: topLevelConfig(cty.NullVal(cty.String), cty.UnknownVal(cty.Bool)),
+		},
+		"block not configured": {
+			spec:      blockSpec,
+			rawConfig: blockConfig(),
+		},
+		"block KMS key configured": {
+			spec: blockSpec,
+			rawConfig: blockConfig(cty.ObjectVal(map[string]cty.Value{
+				"kms_key_arn": cty.StringVal("arn:aws:kms:us-west-2:123456789012:key/example"), //lintignore:AWSAT003,AWSAT005
+				"enabled":     cty.True,
+			})),
+			wantKMSKeyConfigured: true,
+		},
+		"block unencrypted": {
+			spec: blockSpec,
+			rawConfig: blockConfig(cty.ObjectVal(map[string]cty.Value{
+				"kms_key_arn": cty.NullVal(cty.String),
+				"enabled":     cty.False,
+			})),
+			wantUnencrypted: true,
+		},
+		"null config": {
+			spec:      topLevelSpec,
+			rawConfig: cty.NullVal(cty.DynamicPseudoType),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := testCase.spec.isKMSKeyConfigured(testCase.rawConfig), testCase.wantKMSKeyConfigured; got != want {
+				t.Errorf("isKMSKeyConfigured() = %t, want %t", got, want)
+			}
+			if got, want := testCase.spec.isUnencrypted(testCase.rawConfig), testCase.wantUnencrypted; got != want {
+				t.Errorf("isUnencrypted() = %t, want %t", got, want)
+			}
+		})
+	}
+}
+
+func TestDefaultEncryption(t *testing.T) {
+	t.Parallel()
+
+	const (
+		defaultKMSKeyARN    = "arn:aws:kms:us-west-2:123456789012:key/default"    //lintignore:AWSAT003,AWSAT005
+		configuredKMSKeyARN = "arn:aws:kms:us-west-2:123456789012:key/configured" //lintignore:AWSAT003,AWSAT005
+		computed            = "<computed>"
+	)
+
+	ctx := context.Background()
+	p, err := New(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := map[string]struct {
+		typeName           string
+		servicePackageName string
+		computedAttrs      []string
+		rawConfig          map[string]cty.Value
+		state              map[string]string
+		defaultKMSKeyARN   string
+		want               map[string]string
+	}{
+		"top-level not configured": {
+			typeName:           "aws_ebs_volume",
+			servicePackageName: "ec2",
+			defaultKMSKeyARN:   defaultKMSKeyARN,
+			want: map[string]string{
+				"kms_key_id": defaultKMSKeyARN,
+				"encrypted":  "true",
+			},
+		},
+		"top-level KMS key configured": {
+			typeName:           "aws_ebs_volume",
+			servicePackageName: "ec2",
+			rawConfig:          map[string]cty.Value{"kms_key_id": cty.StringVal(configuredKMSKeyARN)},
+			defaultKMSKeyARN:   defaultKMSKeyARN,
+			want: map[string]string{
+				"kms_key_id": configuredKMSKeyARN,
+			},
+		},
+		"top-level unencrypted": {
+			typeName:           "aws_ebs_volume",
+			servicePackageName: "ec2",
+			rawConfig:          map[string]cty.Value{"encrypted": cty.False},
+			defaultKMSKeyARN:   defaultKMSKeyARN,
+			want: map[string]string{
+				"kms_key_id": computed,
+				"encrypted":  "false",
+			},
+		},
+		"encrypted without KMS key": {
+			typeName:           "aws_sqs_queue",
+			servicePackageName: "sqs",
+			computedAttrs:      []string{"kms_master_key_id"},
+			rawConfig:          map[string]cty.Value{"sqs_managed_sse_enabled": cty.True},
+			defaultKMSKeyARN:   defaultKMSKeyARN,
+			want: map[string]string{
+				"kms_master_key_id":       "",
+				"sqs_managed_sse_enabled": "true",
+			},
+		},
+		"no default KMS key": {
+			typeName:           "aws_cloudwatch_log_group",
+			servicePackageName: "logs",
+			computedAttrs:      []string{"kms_key_id"},
+			want: map[string]string{
+				"kms_key_id": "",
+			},
+		},
+		"update default KMS key": {
+			typeName:           "aws_cloudwatch_log_group",
+			servicePackageName: "logs",
+			computedAttrs:      []string{"kms_key_id"},
+			state:              map[string]string{"kms_key_id": defaultKMSKeyARN},
+			defaultKMSKeyARN:   defaultKMSKeyARN,
+			want: map[string]string{
+				"kms_key_id": defaultKMSKeyARN,
+			},
+		},
+		"update KMS key removed": {
+			typeName:           "aws_cloudwatch_log_group",
+			servicePackageName: "logs",
+			computedAttrs:      []string{"kms_key_id"},
+			state:              map[string]string{"kms_key_id": configuredKMSKeyARN},
+			defaultKMSKeyARN:   defaultKMSKeyARN,
+			want: map[string]string{
+				// The SDK plans an empty computed string as unknown.
+				"kms_key_id": computed,
+			},
+		},
+		"update encrypted removed": {
+			typeName:           "aws_db_instance",
+			servicePackageName: "rds",
+			computedAttrs:      []string{"storage_encrypted"},
+			state:              map[string]string{"storage_encrypted": "true"},
+			want: map[string]string{
+				"storage_encrypted": "false",
+			},
+		},
+		"block": {
+			typeName:           "aws_dynamodb_table",
+			servicePackageName: "dynamodb",
+			rawConfig: map[string]cty.Value{
+				"server_side_encryption": cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{
+					"enabled":     cty.True,
+					"kms_key_arn": cty.NullVal(cty.String),
+				})}),
+			},
+			defaultKMSKeyARN: defaultKMSKeyARN,
+			want: map[string]string{
+				"server_side_encryption.0.enabled":     "true",
+				"server_side_encryption.0.kms_key_arn": defaultKMSKeyARN,
+			},
+		},
+		"block value not configured": {
+			typeName:           "aws_s3_bucket",
+			servicePackageName: "s3",
+			defaultKMSKeyARN:   defaultKMSKeyARN,
+			want: map[string]string{
+				"server_side_encryption_configuration.0.rule.0.apply_server_side_encryption_by_default.0.kms_master_key_id": defaultKMSKeyARN,
+				"server_side_encryption_configuration.0.rule.0.apply_server_side_encryption_by_default.0.sse_algorithm":     "aws:kms",
+			},
+		},
+		"block value configured": {
+			typeName:           "aws_s3_bucket",
+			servicePackageName: "s3",
+			rawConfig: map[string]cty.Value{
+				"server_side_encryption_configuration": cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{
+					"rule": cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{
+						"apply_server_side_encryption_by_default": cty.ListVal([]cty.Value{cty.ObjectVal(map[string]cty.Value{
+							"kms_master_key_id": cty.NullVal(cty.String),
+							"sse_algorithm":     cty.StringVal("AES256"),
+						})}),
+						"bucket_key_enabled": cty.NullVal(cty.Bool),
+					})}),
+				})}),
+			},
+			defaultKMSKeyARN: defaultKMSKeyARN,
+			want: map[string]string{
+				"server_side_encryption_configuration.0.rule.0.apply_server_side_encryption_by_default.0.kms_master_key_id": "",
+				"server_side_encryption_configuration.0.rule.0.apply_server_side_encryption_by_default.0.sse_algorithm":     "AES256",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			v, ok := p.ResourcesMap[testCase.typeName]
+			if !ok {
+				t.Fatalf("resource type %s not found", testCase.typeName)
+			}
+
+			spec, ok := defaultEncryptionResourceSpecs[testCase.typeName]
+			if !ok {
+				t.Fatalf("resource type %s has no default encryption spec", testCase.typeName)
+			}
+
+			r := &schema.Resource{
+				Schema:        v.SchemaMap(),
+				CustomizeDiff: defaultEncryption(testCase.servicePackageName, spec, testCase.computedAttrs),
+			}
+			c := &conns.AWSClient{}
+			conns.SetDefaultEncryptionConfig(c, &tfencryption.DefaultConfig{
+				KMSKeyARN: testCase.defaultKMSKeyARN,
+			})
+			rawConfig := testResourceRawConfig(r, testCase.rawConfig)
+			state := &terraformsdk.InstanceState{RawConfig: rawConfig}
+			if testCase.state != nil {
+				state.ID = "test"
+				state.Attributes = testCase.state
+			}
+
+			diff, err := r.SimpleDiff(ctx, state, terraformsdk.NewResourceConfigShimmed(rawConfig, r.CoreConfigSchema()), c)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			for k, want := range testCase.want {
+				got := state.Attributes[k]
+				if diff != nil {
+					if v, ok := diff.Attributes[k]; ok {
+						if v.NewComputed {
+							got = computed
+						} else {
+							got = v.New
+						}
+					}
+				}
+
+				if got != want {
+					t.Errorf("%s = %q, want %q", k, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestRequireEncryption(t *testing.T) {
+	t.Parallel()
+
+	const (
+		kmsKeyARN = "arn:aws:kms:us-west-2:123456789012:key/example" //lintignore:AWSAT003,AWSAT005
+	)
+
+	ctx := context.Background()
+	p, err := New(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := map[string]struct {
+		typeName  string
+		rawConfig map[string]cty.Value
+		config    *tfencryption.DefaultConfig
+		wantErr   bool
+	}{
+		"not required": {
+			typeName:  "aws_ebs_volume",
+			rawConfig: map[string]cty.Value{"encrypted": cty.False},
+			config:    &tfencryption.DefaultConfig{},
+		},
+		"unencrypted": {
+			typeName:  "aws_ebs_volume",
+			rawConfig: map[string]cty.Value{"encrypted": cty.False},
+			config:    &tfencryption.DefaultConfig{RequireEncryption: true},
+			wantErr:   true,
+		},
+		"encrypted": {
+			typeName:  "aws_ebs_volume",
+			rawConfig: map[string]cty.Value{"encrypted": cty.True},
+			config:    &tfencryption.DefaultConfig{RequireEncryption: true},
+		},
+		"RDS cluster unencrypted": {
+			typeName:  "aws_rds_cluster",
+			rawConfig: map[string]cty.Value{"storage_encrypted": cty.False},
+			config:    &tfencryption.DefaultConfig{RequireEncryption: true},
+			wantErr:   true,
+		},
+		"unencrypted KMS key configured": {
+			typeName:  "aws_ebs_volume",
+			rawConfig: map[string]cty.Value{"encrypted": cty.False, "kms_key_id": cty.StringVal(kmsKeyARN)},
+			config:    &tfencryption.DefaultConfig{RequireEncryption: true},
+		},
+		"SQS unencrypted": {
+			typeName:  "aws_sqs_queue",
+			rawConfig: map[string]cty.Value{"sqs_managed_sse_enabled": cty.False},
+			config:    &tfencryption.DefaultConfig{RequireEncryption: true},
+			wantErr:   true,
+		},
+		"SQS encrypted without KMS key": {
+			typeName:  "aws_sqs_queue",
+			rawConfig: map[string]cty.Value{"sqs_managed_sse_enabled": cty.True},
+			config:    &tfencryption.DefaultConfig{RequireEncryption: true},
+		},
+		"encrypted by default": {
+			typeName: "aws_cloudwatch_log_group",
+			config:   &tfencryption.DefaultConfig{RequireEncryption: true},
+		},
+		"block value": {
+			typeName: "aws_s3_bucket",
+			config:   &tfencryption.DefaultConfig{RequireEncryption: true},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			v, ok := p.ResourcesMap[testCase.typeName]
+			if !ok {
+				t.Fatalf("resource type %s not found", testCase.typeName)
+			}
+
+			spec, ok := defaultEncryptionResourceSpecs[testCase.typeName]
+			if !ok {
+				t.Fatalf("resource type %s has no default encryption spec", testCase.typeName)
+			}
+
+			r := &schema.Resource{
+				Schema:        v.SchemaMap(),
+				CustomizeDiff: requireEncryption(spec),
+			}
+			c := &conns.AWSClient{}
+			conns.SetDefaultEncryptionConfig(c, testCase.config)
+			rawConfig := testResourceRawConfig(r, testCase.rawConfig)
+
+			_, err := r.SimpleDiff(ctx, &terraformsdk.InstanceState{RawConfig: rawConfig}, terraformsdk.NewResourceConfigShimmed(rawConfig, r.CoreConfigSchema()), c)
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Errorf("error = %v, want error %t", err, want)
+			}
+		})
+	}
+}
+
+// testResourceRawConfig returns the raw configuration of a resource with the specified attribute values.
+// All other attributes are null.
+func testResourceRawConfig(r *schema.Resource, values map[string]cty.Value) cty.Value {
+	attributes := make(map[string]cty.Value)
+	for k, typ := range r.CoreConfigSchema().ImpliedType().AttributeTypes() {
+		if v, ok := values[k]; ok {
+			attributes[k] = v
+		} else {
+			attributes[k] = cty.NullVal(typ)
+		}
+	}
+
+	return cty.ObjectVal(attributes)
+}
@@ -13,6 +13,14 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/provider/fwprovider"
 )
 
+// protoV5ProviderServer is a terraform-plugin-go protocol v5 provider server that implements all optional RPCs.
+// Provider servers that wrap the muxed provider server must embed this interface so that the
+// optional RPCs (e.g. actions and list resources) remain visible to terraform-plugin-go's type assertions.
+type protoV5ProviderServer interface {
+	tfprotov5.ProviderServerWithActions
+	tfprotov5.ProviderServerWithListResource
+}
+
 // ProtoV5ProviderServerFactory returns a muxed terraform-plugin-go protocol v5 provider factory function.
 // This factory function is suitable for use with the terraform-plugin-go Serve function.
 // The primary (Plugin SDK) provider server is also returned (useful for testing).
@@ -34,5 +42,9 @@ func ProtoV5ProviderServerFactory(ctx context.Context) (func() tfprotov5.Provide
 		return nil, nil, err
 	}
 
-	return muxServer.ProviderServer, primary, nil
+	server := newPolicyValidationProviderServer(newPermissionPreflightProviderServer(muxServer, primary.Meta), primary.Meta)
+
+	return func() tfprotov5.ProviderServer {
+		return server
+	}, primary, nil
 }
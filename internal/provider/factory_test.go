@@ -5,8 +5,17 @@ package provider_test
 
 import (
 	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"sync/atomic"
 	"testing"
 
+	"github.com/hashicorp/aws-sdk-go-base/v2/servicemocks"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider"
 )
 
@@ -22,3 +31,162 @@ func BenchmarkProtoV5ProviderServerFactory(b *testing.B) {
 		b.Logf("%d resources, %d data sources", len(p.ResourcesMap), len(p.DataSourcesMap))
 	}
 }
+
+func TestProtoV5ProviderServerFactory_InvokeAction(t *testing.T) { //nolint:paralleltest // Uses t.Setenv.
+	ctx := context.Background()
+
+	servicemocks.InitSessionTestEnv(t)
+
+	var invocations atomic.Int32
+	lambdaServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || !strings.HasSuffix(r.URL.Path, "/functions/test/invocations") {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		invocations.Add(1)
+
+		w.Header().Set("X-Amz-Executed-Version", "$LATEST")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{}`)) //nolint:errcheck // Test code.
+	}))
+	defer lambdaServer.Close()
+
+	factory, _, err := provider.ProtoV5ProviderServerFactory(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	server := factory()
+
+	actionServer, ok := server.(tfprotov5.ProviderServerWithActions)
+	if !ok {
+		t.Fatal("provider server does not implement tfprotov5.ProviderServerWithActions")
+	}
+	if _, ok := server.(tfprotov5.ProviderServerWithListResource); !ok {
+		t.Fatal("provider server does not implement tfprotov5.ProviderServerWithListResource")
+	}
+
+	schemaResponse, err := server.GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hasErrorDiagnostics(schemaResponse.Diagnostics) {
+		t.Fatalf("unexpected GetProviderSchema diagnostics: %s", diagnosticsString(schemaResponse.Diagnostics))
+	}
+
+	providerType := schemaResponse.Provider.ValueType()
+	var endpoints tftypes.Value
+	switch typ := providerType.(tftypes.Object).AttributeTypes["endpoints"].(type) {
+	case tftypes.List:
+		endpoints = tftypes.NewValue(typ, []tftypes.Value{objectValue(t, typ.ElementType, map[string]tftypes.Value{
+			"lambda": tftypes.NewValue(tftypes.String, lambdaServer.URL),
+		})})
+	case tftypes.Set:
+		endpoints = tftypes.NewValue(typ, []tftypes.Value{objectValue(t, typ.ElementType, map[string]tftypes.Value{
+			"lambda": tftypes.NewValue(tftypes.String, lambdaServer.URL),
+		})})
+	default:
+		t.Fatalf("unexpected endpoints type: %s", typ)
+	}
+
+	configureResponse, err := server.ConfigureProvider(ctx, &tfprotov5.ConfigureProviderRequest{
+		Config: dynamicValue(t, providerType, objectValue(t, providerType, map[string]tftypes.Value{
+			"access_key":                  tftypes.NewValue(tftypes.String, servicemocks.MockStaticAccessKey),
+			"endpoints":                   endpoints,
+			"region":                      tftypes.NewValue(tftypes.String, "us-west-2"), //lintignore:AWSAT003
+			"secret_key":                  tftypes.NewValue(tftypes.String, servicemocks.MockStaticSecretKey),
+			"skip_credentials_validation": tftypes.NewValue(tftypes.Bool, true),
+			"skip_requesting_account_id":  tftypes.NewValue(tftypes.Bool, true),
+		})),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hasErrorDiagnostics(configureResponse.Diagnostics) {
+		t.Fatalf("unexpected ConfigureProvider diagnostics: %s", diagnosticsString(configureResponse.Diagnostics))
+	}
+
+	const actionType = "aws_lambda_invoke"
+	actionSchema, ok := schemaResponse.ActionSchemas[actionType]
+	if !ok {
+		t.Fatalf("action %s not found", actionType)
+	}
+
+	actionValueType := actionSchema.Schema.ValueType()
+	stream, err := actionServer.InvokeAction(ctx, &tfprotov5.InvokeActionRequest{
+		ActionType: actionType,
+		Config: dynamicValue(t, actionValueType, objectValue(t, actionValueType, map[string]tftypes.Value{
+			"function_name": tftypes.NewValue(tftypes.String, "test"),
+			"payload":       tftypes.NewValue(tftypes.String, `{}`),
+		})),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var completed bool
+	for event := range stream.Events {
+		if v, ok := event.Type.(tfprotov5.CompletedInvokeActionEventType); ok {
+			completed = true
+
+			if hasErrorDiagnostics(v.Diagnostics) {
+				t.Errorf("unexpected InvokeAction diagnostics: %s", diagnosticsString(v.Diagnostics))
+			}
+		}
+	}
+
+	if !completed {
+		t.Error("InvokeAction did not complete")
+	}
+	if got, want := invocations.Load(), int32(1); got != want {
+		t.Errorf("Lambda invocations = %d, want %d", got, want)
+	}
+}
+
+// objectValue returns a value of the specified object type with the specified attribute values.
+// All other attributes are null.
+func objectValue(t *testing.T, typ tftypes.Type, values map[string]tftypes.Value) tftypes.Value {
+	t.Helper()
+
+	objectType, ok := typ.(tftypes.Object)
+	if !ok {
+		t.Fatalf("unexpected type: %s", typ)
+	}
+
+	attributes := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for k, v := range objectType.AttributeTypes {
+		if value, ok := values[k]; ok {
+			attributes[k] = value
+		} else {
+			attributes[k] = tftypes.NewValue(v, nil)
+		}
+	}
+
+	return tftypes.NewValue(objectType, attributes)
+}
+
+func dynamicValue(t *testing.T, typ tftypes.Type, value tftypes.Value) *tfprotov5.DynamicValue {
+	t.Helper()
+
+	v, err := tfprotov5.NewDynamicValue(typ, value)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	return &v
+}
+
+func hasErrorDiagnostics(diags []*tfprotov5.Diagnostic) bool {
+	return slices.ContainsFunc(diags, func(d *tfprotov5.Diagnostic) bool {
+		return d.Severity == tfprotov5.DiagnosticSeverityError
+	})
+}
+
+func diagnosticsString(diags []*tfprotov5.Diagnostic) string {
+	var b strings.Builder
+	for _, d := range diags {
+		fmt.Fprintf(&b, "\n%s: %s: %s", d.Severity, d.Summary, d.Detail)
+	}
+	return b.String()
+}
@@ -9,6 +9,7 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
@@ -31,6 +32,7 @@ import (
 var _ provider.Provider = &fwprovider{}
 var _ provider.ProviderWithFunctions = &fwprovider{}
 var _ provider.ProviderWithEphemeralResources = &fwprovider{}
+var _ provider.ProviderWithActions = &fwprovider{}
 
 // New returns a new, initialized Terraform Plugin Framework-style provider instance.
 // The provider instance is fully configured once the `Configure` method has been called.
@@ -85,6 +87,10 @@ func (*fwprovider) Schema(ctx context.Context, request provider.SchemaRequest, r
 				Optional:    true,
 				Description: "URL of a proxy to use for HTTPS requests when accessing the AWS API. Can also be set using the `HTTPS_PROXY` or `https_proxy` environment variables.",
 			},
+			"iam_policy_validation": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Validate IAM policy arguments during plan using IAM Access Analyzer. Errors are reported as errors and security warnings as warnings.",
+			},
 			"insecure": schema.BoolAttribute{
 				Optional:    true,
 				Description: "Explicitly allow the provider to perform \"insecure\" SSL requests. If omitted, default value is `false`",
@@ -97,6 +103,18 @@ func (*fwprovider) Schema(ctx context.Context, request provider.SchemaRequest, r
 				Optional:    true,
 				Description: "Comma-separated list of hosts that should not use HTTP or HTTPS proxies. Can also be set using the `NO_PROXY` or `no_proxy` environment variables.",
 			},
+			"offline": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Run without AWS access. Credentials validation and account ID lookup are skipped and read-only AWS API calls are answered from the fixtures in `offline_fixtures_path`.",
+			},
+			"offline_fixtures_path": schema.StringAttribute{
+				Optional:    true,
+				Description: "Path to the directory containing the AWS API response fixtures used in offline mode.",
+			},
+			"permission_preflight": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Check during plan that the caller has the IAM permissions required by planned changes, using the IAM policy simulator. Denied actions are reported as warnings. Only the resource types listed in the provider documentation are checked.",
+			},
 			"profile": schema.StringAttribute{
 				Optional:    true,
 				Description: "The profile for API operations. If not set, the default profile\ncreated with `aws configure` will be used.",
@@ -254,6 +272,29 @@ func (*fwprovider) Schema(ctx context.Context, request provider.SchemaRequest, r
 					},
 				},
 			},
+			"default_encryption": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				Description: "Configuration block with settings to default resource encryption across all resources.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrKMSKeyARN: schema.StringAttribute{
+							Optional:    true,
+							Description: "ARN of the KMS key used to encrypt resources that support KMS keys when no key is configured.",
+						},
+						"kms_key_arns": schema.MapAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "ARNs of service-specific KMS keys, keyed by service name. Overrides `kms_key_arn`.",
+						},
+						"require_encryption": schema.BoolAttribute{
+							Optional:    true,
+							Description: "Fail plan for resources explicitly configured unencrypted.",
+						},
+					},
+				},
+			},
 			"default_tags": schema.ListNestedBlock{
 				Validators: []validator.List{
 					listvalidator.SizeAtMost(1),
@@ -306,6 +347,7 @@ func (p *fwprovider) Configure(ctx context.Context, request provider.ConfigureRe
 	response.DataSourceData = v
 	response.ResourceData = v
 	response.EphemeralResourceData = v
+	response.ActionData = v
 }
 
 // DataSources returns a slice of functions to instantiate each DataSource
@@ -525,6 +567,61 @@ func (p *fwprovider) EphemeralResources(ctx context.Context) []func() ephemeral.
 	return ephemeralResources
 }
 
+// Actions returns a slice of functions to instantiate each Action
+// implementation.
+//
+// All actions must have unique type names.
+func (p *fwprovider) Actions(ctx context.Context) []func() action.Action {
+	var errs []error
+	var actions []func() action.Action
+
+	for n, sp := range p.Primary.Meta().(*conns.AWSClient).ServicePackages(ctx) {
+		if data, ok := sp.(conns.ServicePackageWithActions); ok {
+			servicePackageName := data.ServicePackageName()
+
+			for _, v := range data.Actions(ctx) {
+				inner, err := v.Factory(ctx)
+
+				if err != nil {
+					tflog.Warn(ctx, "creating action", map[string]any{
+						"service_package_name": n,
+						"error":                err.Error(),
+					})
+
+					continue
+				}
+
+				opts := wrappedActionOptions{
+					// bootstrapContext is run on all wrapped methods before any interceptors.
+					bootstrapContext: func(ctx context.Context, _ getAttributeFunc, c *conns.AWSClient) (context.Context, diag.Diagnostics) {
+						var diags diag.Diagnostics
+
+						ctx = conns.NewActionContext(ctx, servicePackageName, v.Name)
+						if c != nil {
+							ctx = c.RegisterLogger(ctx)
+							ctx = flex.RegisterLogger(ctx)
+							ctx = logging.MaskSensitiveValuesByKey(ctx, logging.HTTPKeyRequestBody, logging.HTTPKeyResponseBody)
+						}
+						return ctx, diags
+					},
+					typeName: v.TypeName,
+				}
+				actions = append(actions, func() action.Action {
+					return newWrappedAction(inner, opts)
+				})
+			}
+		}
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		tflog.Warn(ctx, "registering actions", map[string]any{
+			"error": err.Error(),
+		})
+	}
+
+	return actions
+}
+
 // Functions returns a slice of functions to instantiate each Function
 // implementation.
 //
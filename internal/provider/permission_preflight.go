@@ -0,0 +1,195 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+)
+
+// resourceActions are the IAM actions required to change a resource of a specific type.
+type resourceActions struct {
+	create []string
+	update []string
+	delete []string
+}
+
+// permissionPreflightProviderServer is a provider server that, if enabled in the provider configuration,
+// simulates the caller's IAM policies during plan and warns of any IAM actions required by the planned change that are denied.
+// See internal/provider/permission_preflight_actions.csv for the IAM actions required for each resource type.
+type permissionPreflightProviderServer struct {
+	protoV5ProviderServer
+
+	meta func() any
+
+	mutex     sync.Mutex
+	callerARN string
+	decisions map[string]awstypes.PolicyEvaluationDecisionType // Cached per-action decisions.
+}
+
+func newPermissionPreflightProviderServer(server protoV5ProviderServer, meta func() any) *permissionPreflightProviderServer {
+	return &permissionPreflightProviderServer{
+		protoV5ProviderServer: server,
+		meta:                  meta,
+		decisions:             make(map[string]awstypes.PolicyEvaluationDecisionType),
+	}
+}
+
+func (s *permissionPreflightProviderServer) PlanResourceChange(ctx context.Context, request *tfprotov5.PlanResourceChangeRequest) (*tfprotov5.PlanResourceChangeResponse, error) {
+	response, err := s.protoV5ProviderServer.PlanResourceChange(ctx, request)
+
+	if err != nil || response == nil {
+		return response, err
+	}
+
+	c, ok := s.meta().(*conns.AWSClient)
+	if !ok || !c.PermissionPreflight(ctx) {
+		return response, nil
+	}
+
+	if slices.ContainsFunc(response.Diagnostics, func(d *tfprotov5.Diagnostic) bool {
+		return d.Severity == tfprotov5.DiagnosticSeverityError
+	}) {
+		return response, nil
+	}
+
+	change, actions := plannedChangeActions(request.TypeName, request.PriorState, response.PlannedState, len(response.RequiresReplace) > 0)
+	if len(actions) == 0 {
+		return response, nil
+	}
+
+	callerARN, denied, err := s.deniedActions(ctx, c, actions)
+	if err != nil {
+		response.Diagnostics = append(response.Diagnostics, &tfprotov5.Diagnostic{
+			Severity: tfprotov5.DiagnosticSeverityWarning,
+			Summary:  "Unable to check IAM permissions",
+			Detail:   fmt.Sprintf("The IAM permissions required to %s this %s could not be checked: %s", change, request.TypeName, err),
+		})
+
+		return response, nil
+	}
+
+	if len(denied) > 0 {
+		response.Diagnostics = append(response.Diagnostics, &tfprotov5.Diagnostic{
+			Severity: tfprotov5.DiagnosticSeverityWarning,
+			Summary:  "Insufficient IAM permissions",
+			Detail: fmt.Sprintf("The IAM policy simulator reports that the caller (%s) is not allowed to perform actions required to %s this %s: %s.\n\n"+
+				"Applying this plan may fail part way through.", callerARN, change, request.TypeName, strings.Join(denied, ", ")),
+		})
+	}
+
+	return response, nil
+}
+
+// deniedActions returns the caller's ARN and those of the specified IAM actions that the caller is not allowed to perform.
+func (s *permissionPreflightProviderServer) deniedActions(ctx context.Context, c *conns.AWSClient, actions []string) (string, []string, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.callerARN == "" {
+		output, err := c.STSClient(ctx).GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{})
+		if err != nil {
+			return "", nil, fmt.Errorf("reading caller identity: %w", err)
+		}
+
+		s.callerARN = aws.ToString(output.Arn)
+	}
+
+	var unknown []string
+	for _, action := range actions {
+		if _, ok := s.decisions[action]; !ok {
+			unknown = append(unknown, action)
+		}
+	}
+
+	if len(unknown) > 0 {
+		tflog.Debug(ctx, "Simulating IAM actions", map[string]any{
+			"caller_arn": s.callerARN,
+			"actions":    unknown,
+		})
+
+		decisions, err := tfiam.SimulatePrincipalActions(ctx, c.IAMClient(ctx), s.callerARN, unknown)
+		if err != nil {
+			return "", nil, err
+		}
+
+		for k, v := range decisions {
+			s.decisions[k] = v
+		}
+	}
+
+	var denied []string
+	for _, action := range actions {
+		if v, ok := s.decisions[action]; ok && v != awstypes.PolicyEvaluationDecisionTypeAllowed {
+			denied = append(denied, action)
+		}
+	}
+
+	return s.callerARN, denied, nil
+}
+
+// plannedChangeActions returns the planned change ("create", "update", "replace" or "delete")
+// and the IAM actions required to apply it to a resource of the specified type.
+func plannedChangeActions(typeName string, priorState, plannedState *tfprotov5.DynamicValue, requiresReplace bool) (string, []string) {
+	v, ok := resourceTypeActions[typeName]
+	if !ok {
+		return "", nil
+	}
+
+	var change string
+	var actions []string
+
+	switch priorNull, plannedNull := isNullDynamicValue(priorState), isNullDynamicValue(plannedState); {
+	case priorNull && plannedNull:
+		return "", nil
+	case priorNull:
+		change, actions = "create", v.create
+	case plannedNull:
+		// Terraform plans deletions with the provider because tf5muxserver enables the PlanDestroy server capability.
+		change, actions = "delete", v.delete
+	case requiresReplace:
+		change, actions = "replace", append(slices.Clone(v.delete), v.create...)
+	case !bytes.Equal(priorState.MsgPack, plannedState.MsgPack) || !bytes.Equal(priorState.JSON, plannedState.JSON):
+		change, actions = "update", v.update
+	default:
+		return "", nil
+	}
+
+	actions = slices.Clone(actions)
+	slices.Sort(actions)
+
+	return change, slices.Compact(actions)
+}
+
+// isNullDynamicValue returns whether the specified value is missing or null.
+func isNullDynamicValue(v *tfprotov5.DynamicValue) bool {
+	if v == nil {
+		return true
+	}
+
+	if len(v.MsgPack) > 0 {
+		const (
+			msgPackNil = 0xc0
+		)
+		return len(v.MsgPack) == 1 && v.MsgPack[0] == msgPackNil
+	}
+
+	if len(v.JSON) > 0 {
+		return string(v.JSON) == "null"
+	}
+
+	return true
+}
@@ -0,0 +1,141 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestPlannedChangeActions(t *testing.T) {
+	t.Parallel()
+
+	objectType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"name": tftypes.String,
+	}}
+	dynamicValue := func(t *testing.T, v tftypes.Value) *tfprotov5.DynamicValue {
+		t.Helper()
+
+		dv, err := tfprotov5.NewDynamicValue(objectType, v)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		return &dv
+	}
+	null := tftypes.NewValue(objectType, nil)
+	value := func(name string) tftypes.Value {
+		return tftypes.NewValue(objectType, map[string]tftypes.Value{
+			"name": tftypes.NewValue(tftypes.String, name),
+		})
+	}
+
+	testCases := map[string]struct {
+		typeName        string
+		priorState      tftypes.Value
+		plannedState    tftypes.Value
+		requiresReplace bool
+		wantChange      string
+		wantActions     []string
+	}{
+		"unknown resource type": {
+			typeName:     "aws_example_thing",
+			priorState:   null,
+			plannedState: value("a"),
+		},
+		"create": {
+			typeName:     "aws_sqs_queue",
+			priorState:   null,
+			plannedState: value("a"),
+			wantChange:   "create",
+			wantActions:  []string{"sqs:CreateQueue", "sqs:GetQueueAttributes", "sqs:GetQueueUrl", "sqs:ListQueueTags", "sqs:TagQueue"},
+		},
+		"update": {
+			typeName:     "aws_sqs_queue",
+			priorState:   value("a"),
+			plannedState: value("b"),
+			wantChange:   "update",
+			wantActions:  []string{"sqs:SetQueueAttributes", "sqs:TagQueue", "sqs:UntagQueue"},
+		},
+		"no change": {
+			typeName:     "aws_sqs_queue",
+			priorState:   value("a"),
+			plannedState: value("a"),
+		},
+		"replace": {
+			typeName:        "aws_sqs_queue",
+			priorState:      value("a"),
+			plannedState:    value("b"),
+			requiresReplace: true,
+			wantChange:      "replace",
+			wantActions:     []string{"sqs:CreateQueue", "sqs:DeleteQueue", "sqs:GetQueueAttributes", "sqs:GetQueueUrl", "sqs:ListQueueTags", "sqs:TagQueue"},
+		},
+		"delete": {
+			typeName:     "aws_sqs_queue",
+			priorState:   value("a"),
+			plannedState: null,
+			wantChange:   "delete",
+			wantActions:  []string{"sqs:DeleteQueue"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			gotChange, gotActions := plannedChangeActions(testCase.typeName, dynamicValue(t, testCase.priorState), dynamicValue(t, testCase.plannedState), testCase.requiresReplace)
+
+			if got, want := gotChange, testCase.wantChange; got != want {
+				t.Errorf("change = %q, want %q", got, want)
+			}
+			if diff := cmp.Diff(gotActions, testCase.wantActions); diff != "" {
+				t.Errorf("unexpected actions diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestIsNullDynamicValue(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value *tfprotov5.DynamicValue
+		want  bool
+	}{
+		"nil": {
+			want: true,
+		},
+		"empty": {
+			value: &tfprotov5.DynamicValue{},
+			want:  true,
+		},
+		"MsgPack null": {
+			value: &tfprotov5.DynamicValue{MsgPack: []byte{0xc0}},
+			want:  true,
+		},
+		"MsgPack not null": {
+			value: &tfprotov5.DynamicValue{MsgPack: []byte{0x80}},
+		},
+		"JSON null": {
+			value: &tfprotov5.DynamicValue{JSON: []byte("null")},
+			want:  true,
+		},
+		"JSON not null": {
+			value: &tfprotov5.DynamicValue{JSON: []byte("{}")},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := isNullDynamicValue(testCase.value), testCase.want; got != want {
+				t.Errorf("isNullDynamicValue() = %t, want %t", got, want)
+			}
+		})
+	}
+}
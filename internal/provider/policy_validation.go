@@ -0,0 +1,508 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/accessanalyzer"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/accessanalyzer/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfaccessanalyzer "github.com/hashicorp/terraform-provider-aws/internal/service/accessanalyzer"
+)
+
+// policyAttribute is an IAM policy argument of a resource type.
+type policyAttribute struct {
+	path         []string // Attribute names. All elements of intermediate lists and sets are traversed.
+	policyType   awstypes.PolicyType
+	resourceType awstypes.ValidatePolicyResourceType
+}
+
+// policyAttributes are the IAM policy arguments of each resource type validated during plan.
+// Keep the list in website/docs/index.html.markdown in sync.
+var policyAttributes = map[string][]policyAttribute{
+	"aws_acmpca_policy": {
+		{path: []string{"policy"}, policyType: awstypes.PolicyTypeResourcePolicy},
+	},
+	"aws_api_gateway_rest_api": {
+		{path: []string{"policy"}, policyType: awstypes.PolicyTypeResourcePolicy},
+	},
+	"aws_api_gateway_rest_api_policy": {
+		{path: []string{"policy"}, policyType: awstypes.PolicyTypeResourcePolicy},
+	},
+	"aws_backup_vault_policy": {
+		{path: []string{"policy"}, policyType: awstypes.PolicyTypeResourcePolicy},
+	},
+	"aws_cloudwatch_event_bus_policy": {
+		{path: []string{"policy"}, policyType: awstypes.PolicyTypeResourcePolicy},
+	},
+	"aws_cloudwatch_log_destination_policy": {
+		{path: []string{"access_policy"}, policyType: awstypes.PolicyTypeResourcePolicy},
+	},
+	"aws_cloudwatch_log_resource_policy": {
+		{path: []string{"policy_document"}, policyType: awstypes.PolicyTypeResourcePolicy},
+	},
+	"aws_codeartifact_domain_permissions_policy": {
+		{path: []string{"policy_document"}, policyType: awstypes.PolicyTypeResourcePolicy},
+	},
+	"aws_codeartifact_repository_permissions_policy": {
+		{path: []string{"policy_document"}, policyType: awstypes.PolicyTypeResourcePolicy},
+	},
+	"aws_dynamodb_resource_policy": {
+		{path: []string{"policy"}, policyType: awstypes.PolicyTypeResourcePolicy},
+	},
+	"aws_ecr_registry_policy": {
+		{path: []string{"policy"}, policyType: awstypes.PolicyTypeResourcePolicy},
+	},
+	"aws_ecr_repository_policy": {
+		{path: []string{"policy"}, policyType: awstypes.PolicyTypeResourcePolicy},
+	},
+	"aws_ecrpublic_repository_policy": {
+		{path: []string{"policy"}, policyType: awstypes.PolicyTypeResourcePolicy},
+	},
+	"aws_efs_file_system_policy": {
+		{path: []string{"policy"}, policyType: awstypes.PolicyTypeResourcePolicy},
+	},
+	"aws_elasticsearch_domain": {
+		{path: []string{"access_policies"}, policyType: awstypes.PolicyTypeResourcePolicy},
+	},
+	"aws_elasticsearch_domain_policy": {
+		{path: []string{"access_policies"}, policyType: awstypes.PolicyTypeResourcePolicy},
+	},
+	"aws_glacier_vault": {
+		{path: []string{"access_policy"}, policyType: awstypes.PolicyTypeResourcePolicy},
+	},
+	"aws_glue_resource_policy": {
+		{path: []string{"policy"}, policyType: awstypes.PolicyTypeResourcePolicy},
+	},
+	"aws_iam_group_policy": {
+		{path: []string{"policy"}, policyType: awstypes.PolicyTypeIdentityPolicy},
+	},
+	"aws_iam_policy": {
+		{path: []string{"policy"}, policyType: awstypes.PolicyTypeIdentityPolicy},
+	},
+	"aws_iam_role": {
+		{path: []string{"assume_role_policy"}, policyType: awstypes.PolicyTypeResourcePolicy, resourceType: awstypes.ValidatePolicyResourceTypeRoleTrust},
+		{path: []string{"inline_policy", "policy"}, policyType: awstypes.PolicyTypeIdentityPolicy},
+	},
+	"aws_iam_role_policy": {
+		{path: []string{"policy"}, policyType: awstypes.PolicyTypeIdentityPolicy},
+	},
+	"aws_iam_user_policy": {
+		{path: []string{"policy"}, policyType: awstypes.PolicyTypeIdentityPolicy},
+	},
+	"aws_kinesis_resource_policy": {
+		{path: []string{"policy"}, policyType: awstypes.PolicyTypeResourcePolicy},
+	},
+	"aws_kms_key": {
+		{path: []string{"policy"}, policyType: awstypes.PolicyTypeResourcePolicy},
+	},
+	"aws_kms_key_policy": {
+		{path: []string{"policy"}, policyType: awstypes.PolicyTypeResourcePolicy},
+	},
+	"aws_media_store_container_policy": {
+		{path: []string{"policy"}, policyType: awstypes.PolicyTypeResourcePolicy},
+	},
+	"aws_msk_cluster_policy": {
+		{path: []string{"policy"}, policyType: awstypes.PolicyTypeResourcePolicy},
+	},
+	"aws_networkfirewall_resource_policy": {
+		{path: []string{"policy"}, policyType: awstypes.PolicyTypeResourcePolicy},
+	},
+	"aws_opensearch_domain": {
+		{path: []string{"access_policies"}, policyType: awstypes.PolicyTypeResourcePolicy},
+	},
+	"aws_opensearch_domain_policy": {
+		{path: []string{"access_policies"}, policyType: awstypes.PolicyTypeResourcePolicy},
+	},
+	"aws_redshift_resource_policy": {
+		{path: []string{"policy"}, policyType: awstypes.PolicyTypeResourcePolicy},
+	},
+	"aws_s3_access_point": {
+		{path: []string{"policy"}, policyType: awstypes.PolicyTypeResourcePolicy, resourceType: awstypes.ValidatePolicyResourceTypeS3AccessPoint},
+	},
+	"aws_s3_bucket": {
+		{path: []string{"policy"}, policyType: awstypes.PolicyTypeResourcePolicy, resourceType: awstypes.ValidatePolicyResourceTypeS3Bucket},
+	},
+	"aws_s3_bucket_policy": {
+		{path: []string{"policy"}, policyType: awstypes.PolicyTypeResourcePolicy, resourceType: awstypes.ValidatePolicyResourceTypeS3Bucket},
+	},
+	"aws_s3control_access_point_policy": {
+		{path: []string{"policy"}, policyType: awstypes.PolicyTypeResourcePolicy, resourceType: awstypes.ValidatePolicyResourceTypeS3AccessPoint},
+	},
+	"aws_s3control_bucket_policy": {
+		{path: []string{"policy"}, policyType: awstypes.PolicyTypeResourcePolicy},
+	},
+	"aws_s3control_multi_region_access_point_policy": {
+		{path: []string{"details", "policy"}, policyType: awstypes.PolicyTypeResourcePolicy, resourceType: awstypes.ValidatePolicyResourceTypeS3MultiRegionAccessPoint},
+	},
+	"aws_s3control_object_lambda_access_point_policy": {
+		{path: []string{"policy"}, policyType: awstypes.PolicyTypeResourcePolicy, resourceType: awstypes.ValidatePolicyResourceTypeS3ObjectLambdaAccessPoint},
+	},
+	"aws_sagemaker_model_package_group_policy": {
+		{path: []string{"resource_policy"}, policyType: awstypes.PolicyTypeResourcePolicy},
+	},
+	"aws_schemas_registry_policy": {
+		{path: []string{"policy"}, policyType: awstypes.PolicyTypeResourcePolicy},
+	},
+	"aws_secretsmanager_secret": {
+		{path: []string{"policy"}, policyType: awstypes.PolicyTypeResourcePolicy},
+	},
+	"aws_secretsmanager_secret_policy": {
+		{path: []string{"policy"}, policyType: awstypes.PolicyTypeResourcePolicy},
+	},
+	"aws_sns_topic": {
+		{path: []string{"policy"}, policyType: awstypes.PolicyTypeResourcePolicy},
+	},
+	"aws_sns_topic_policy": {
+		{path: []string{"policy"}, policyType: awstypes.PolicyTypeResourcePolicy},
+	},
+	"aws_sqs_queue": {
+		{path: []string{"policy"}, policyType: awstypes.PolicyTypeResourcePolicy},
+	},
+	"aws_sqs_queue_policy": {
+		{path: []string{"policy"}, policyType: awstypes.PolicyTypeResourcePolicy},
+	},
+	"aws_ssoadmin_permission_set_inline_policy": {
+		{path: []string{"inline_policy"}, policyType: awstypes.PolicyTypeIdentityPolicy},
+	},
+	"aws_vpc_endpoint": {
+		{path: []string{"policy"}, policyType: awstypes.PolicyTypeResourcePolicy},
+	},
+	"aws_vpc_endpoint_policy": {
+		{path: []string{"policy"}, policyType: awstypes.PolicyTypeResourcePolicy},
+	},
+	"aws_xray_resource_policy": {
+		{path: []string{"policy_document"}, policyType: awstypes.PolicyTypeResourcePolicy},
+	},
+}
+
+// policyDocument is an IAM policy document at a specific attribute path.
+type policyDocument struct {
+	path     *tftypes.AttributePath
+	document string
+}
+
+type policyValidationKey struct {
+	document     string
+	policyType   awstypes.PolicyType
+	resourceType awstypes.ValidatePolicyResourceType
+}
+
+// policyValidationProviderServer is a provider server that, if enabled in the provider configuration,
+// validates new and changed IAM policy arguments during plan using IAM Access Analyzer.
+// ERROR findings are reported as errors and SECURITY_WARNING findings as warnings.
+type policyValidationProviderServer struct {
+	protoV5ProviderServer
+
+	meta func() any
+
+	mutex    sync.Mutex
+	types    map[string]tftypes.Type // Resource type schema value types.
+	findings map[policyValidationKey][]awstypes.ValidatePolicyFinding
+}
+
+func newPolicyValidationProviderServer(server protoV5ProviderServer, meta func() any) *policyValidationProviderServer {
+	return &policyValidationProviderServer{
+		protoV5ProviderServer: server,
+		meta:                  meta,
+		findings:              make(map[policyValidationKey][]awstypes.ValidatePolicyFinding),
+	}
+}
+
+func (s *policyValidationProviderServer) PlanResourceChange(ctx context.Context, request *tfprotov5.PlanResourceChangeRequest) (*tfprotov5.PlanResourceChangeResponse, error) {
+	response, err := s.protoV5ProviderServer.PlanResourceChange(ctx, request)
+
+	if err != nil || response == nil {
+		return response, err
+	}
+
+	c, ok := s.meta().(*conns.AWSClient)
+	if !ok || !c.IAMPolicyValidation(ctx) {
+		return response, nil
+	}
+
+	if slices.ContainsFunc(response.Diagnostics, func(d *tfprotov5.Diagnostic) bool {
+		return d.Severity == tfprotov5.DiagnosticSeverityError
+	}) {
+		return response, nil
+	}
+
+	attributes, ok := policyAttributes[request.TypeName]
+	if !ok || isNullDynamicValue(response.PlannedState) {
+		return response, nil
+	}
+
+	documents, err := s.plannedPolicyDocuments(ctx, request.TypeName, attributes, request.PriorState, response.PlannedState)
+	if err != nil {
+		response.Diagnostics = append(response.Diagnostics, &tfprotov5.Diagnostic{
+			Severity: tfprotov5.DiagnosticSeverityWarning,
+			Summary:  "Unable to validate IAM policy",
+			Detail:   fmt.Sprintf("The IAM policies of this %s could not be validated: %s", request.TypeName, err),
+		})
+
+		return response, nil
+	}
+
+	for i, attribute := range attributes {
+		for _, document := range documents[i] {
+			findings, err := s.validatePolicy(ctx, c, policyValidationKey{
+				document:     document.document,
+				policyType:   attribute.policyType,
+				resourceType: attribute.resourceType,
+			})
+			if err != nil {
+				response.Diagnostics = append(response.Diagnostics, &tfprotov5.Diagnostic{
+					Severity:  tfprotov5.DiagnosticSeverityWarning,
+					Summary:   "Unable to validate IAM policy",
+					Detail:    fmt.Sprintf("The IAM policy could not be validated using IAM Access Analyzer: %s", err),
+					Attribute: document.path,
+				})
+
+				continue
+			}
+
+			for _, finding := range findings {
+				var severity tfprotov5.DiagnosticSeverity
+
+				switch finding.FindingType {
+				case awstypes.ValidatePolicyFindingTypeError:
+					severity = tfprotov5.DiagnosticSeverityError
+				case awstypes.ValidatePolicyFindingTypeSecurityWarning:
+					severity = tfprotov5.DiagnosticSeverityWarning
+				default:
+					continue
+				}
+
+				response.Diagnostics = append(response.Diagnostics, &tfprotov5.Diagnostic{
+					Severity:  severity,
+					Summary:   fmt.Sprintf("IAM policy validation %s: %s", strings.ToLower(strings.ReplaceAll(string(finding.FindingType), "_", " ")), aws.ToString(finding.IssueCode)),
+					Detail:    policyValidationFindingDetail(finding),
+					Attribute: document.path,
+				})
+			}
+		}
+	}
+
+	return response, nil
+}
+
+// plannedPolicyDocuments returns, for each of the specified IAM policy arguments, the new or changed policy documents in the planned state.
+func (s *policyValidationProviderServer) plannedPolicyDocuments(ctx context.Context, typeName string, attributes []policyAttribute, priorState, plannedState *tfprotov5.DynamicValue) ([][]policyDocument, error) {
+	typ, err := s.valueType(ctx, typeName)
+	if err != nil {
+		return nil, err
+	}
+
+	planned, err := plannedState.Unmarshal(typ)
+	if err != nil {
+		return nil, fmt.Errorf("decoding planned state: %w", err)
+	}
+
+	prior := tftypes.NewValue(typ, nil)
+	if !isNullDynamicValue(priorState) {
+		if prior, err = priorState.Unmarshal(typ); err != nil {
+			return nil, fmt.Errorf("decoding prior state: %w", err)
+		}
+	}
+
+	documents := make([][]policyDocument, len(attributes))
+	for i, attribute := range attributes {
+		if documents[i], err = changedPolicyDocuments(prior, planned, attribute.path); err != nil {
+			return nil, err
+		}
+	}
+
+	return documents, nil
+}
+
+// valueType returns the value type of the specified resource type's schema.
+func (s *policyValidationProviderServer) valueType(ctx context.Context, typeName string) (tftypes.Type, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.types == nil {
+		response, err := s.protoV5ProviderServer.GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+		if err != nil {
+			return nil, fmt.Errorf("reading provider schema: %w", err)
+		}
+
+		s.types = make(map[string]tftypes.Type, len(response.ResourceSchemas))
+		for k, v := range response.ResourceSchemas {
+			s.types[k] = v.ValueType()
+		}
+	}
+
+	typ, ok := s.types[typeName]
+	if !ok {
+		return nil, fmt.Errorf("schema for %s not found", typeName)
+	}
+
+	return typ, nil
+}
+
+// validatePolicy returns the IAM Access Analyzer findings for the specified policy document.
+func (s *policyValidationProviderServer) validatePolicy(ctx context.Context, c *conns.AWSClient, key policyValidationKey) ([]awstypes.ValidatePolicyFinding, error) {
+	s.mutex.Lock()
+	v, ok := s.findings[key]
+	s.mutex.Unlock()
+
+	if ok {
+		return v, nil
+	}
+
+	tflog.Debug(ctx, "Validating IAM policy", map[string]any{
+		"policy_type":   key.policyType,
+		"resource_type": key.resourceType,
+	})
+
+	input := accessanalyzer.ValidatePolicyInput{
+		PolicyDocument:             aws.String(key.document),
+		PolicyType:                 key.policyType,
+		ValidatePolicyResourceType: key.resourceType,
+	}
+
+	findings, err := tfaccessanalyzer.FindPolicyValidationFindings(ctx, c.AccessAnalyzerClient(ctx), &input)
+	if err != nil {
+		return nil, err
+	}
+
+	// The mutex isn't held during the API call so that concurrent plans aren't serialized.
+	// Concurrent validations of the same policy may each call the API; the results are identical.
+	s.mutex.Lock()
+	s.findings[key] = findings
+	s.mutex.Unlock()
+
+	return findings, nil
+}
+
+// changedPolicyDocuments returns the known, non-empty string values at the specified attribute names in the planned value
+// that differ from the corresponding values in the prior value.
+func changedPolicyDocuments(prior, planned tftypes.Value, names []string) ([]policyDocument, error) {
+	documents, err := policyDocuments(tftypes.NewAttributePath(), planned, names)
+	if err != nil {
+		return nil, err
+	}
+
+	if prior.IsNull() {
+		return documents, nil
+	}
+
+	return slices.DeleteFunc(documents, func(document policyDocument) bool {
+		v, _, err := tftypes.WalkAttributePath(prior, document.path)
+		if err != nil {
+			return false
+		}
+
+		value, ok := v.(tftypes.Value)
+		if !ok || !value.IsKnown() || value.IsNull() {
+			return false
+		}
+
+		var s string
+		if err := value.As(&s); err != nil {
+			return false
+		}
+
+		return s == document.document
+	}), nil
+}
+
+// policyDocuments returns the known, non-empty string values at the specified attribute names in the specified value.
+func policyDocuments(path *tftypes.AttributePath, value tftypes.Value, names []string) ([]policyDocument, error) {
+	if !value.IsKnown() || value.IsNull() {
+		return nil, nil
+	}
+
+	if len(names) == 0 {
+		var s string
+		if err := value.As(&s); err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+
+		if s == "" {
+			return nil, nil
+		}
+
+		return []policyDocument{{path: path, document: s}}, nil
+	}
+
+	var documents []policyDocument
+
+	switch typ := value.Type(); {
+	case typ.Is(tftypes.Object{}):
+		var attributes map[string]tftypes.Value
+		if err := value.As(&attributes); err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+
+		v, ok := attributes[names[0]]
+		if !ok {
+			return nil, fmt.Errorf("%s: attribute %q not found", path, names[0])
+		}
+
+		return policyDocuments(path.WithAttributeName(names[0]), v, names[1:])
+	case typ.Is(tftypes.List{}):
+		var elements []tftypes.Value
+		if err := value.As(&elements); err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+
+		for i, v := range elements {
+			d, err := policyDocuments(path.WithElementKeyInt(i), v, names)
+			if err != nil {
+				return nil, err
+			}
+
+			documents = append(documents, d...)
+		}
+	case typ.Is(tftypes.Set{}):
+		var elements []tftypes.Value
+		if err := value.As(&elements); err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+
+		for _, v := range elements {
+			d, err := policyDocuments(path.WithElementKeyValue(v), v, names)
+			if err != nil {
+				return nil, err
+			}
+
+			documents = append(documents, d...)
+		}
+	default:
+		return nil, fmt.Errorf("%s: unexpected type %s", path, typ)
+	}
+
+	return documents, nil
+}
+
+func policyValidationFindingDetail(finding awstypes.ValidatePolicyFinding) string {
+	var sb strings.Builder
+
+	sb.WriteString(aws.ToString(finding.FindingDetails))
+
+	if n := len(finding.Locations); n > 0 {
+		locations := make([]string, 0, n)
+		for _, v := range finding.Locations {
+			locations = append(locations, tfaccessanalyzer.PolicyLocationPath(v.Path))
+		}
+
+		fmt.Fprintf(&sb, "\n\nLocations: %s", strings.Join(locations, ", "))
+	}
+
+	if v := aws.ToString(finding.LearnMoreLink); v != "" {
+		fmt.Fprintf(&sb, "\n\nLearn more: %s", v)
+	}
+
+	return sb.String()
+}
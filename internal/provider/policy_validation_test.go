@@ -0,0 +1,157 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestChangedPolicyDocuments(t *testing.T) {
+	t.Parallel()
+
+	inlinePolicyType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"name":   tftypes.String,
+		"policy": tftypes.String,
+	}}
+	objectType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"assume_role_policy": tftypes.String,
+		"inline_policy":      tftypes.Set{ElementType: inlinePolicyType},
+	}}
+	inlinePolicy := func(name string, policy any) tftypes.Value {
+		return tftypes.NewValue(inlinePolicyType, map[string]tftypes.Value{
+			"name":   tftypes.NewValue(tftypes.String, name),
+			"policy": tftypes.NewValue(tftypes.String, policy),
+		})
+	}
+	value := func(assumeRolePolicy any, inlinePolicies ...tftypes.Value) tftypes.Value {
+		return tftypes.NewValue(objectType, map[string]tftypes.Value{
+			"assume_role_policy": tftypes.NewValue(tftypes.String, assumeRolePolicy),
+			"inline_policy":      tftypes.NewValue(tftypes.Set{ElementType: inlinePolicyType}, inlinePolicies),
+		})
+	}
+	null := tftypes.NewValue(objectType, nil)
+
+	testCases := map[string]struct {
+		prior     tftypes.Value
+		planned   tftypes.Value
+		names     []string
+		wantPaths []*tftypes.AttributePath
+	}{
+		"create": {
+			prior:     null,
+			planned:   value("a"),
+			names:     []string{"assume_role_policy"},
+			wantPaths: []*tftypes.AttributePath{tftypes.NewAttributePath().WithAttributeName("assume_role_policy")},
+		},
+		"unchanged": {
+			prior:   value("a"),
+			planned: value("a"),
+			names:   []string{"assume_role_policy"},
+		},
+		"changed": {
+			prior:     value("a"),
+			planned:   value("b"),
+			names:     []string{"assume_role_policy"},
+			wantPaths: []*tftypes.AttributePath{tftypes.NewAttributePath().WithAttributeName("assume_role_policy")},
+		},
+		"unknown": {
+			prior:   null,
+			planned: value(tftypes.UnknownValue),
+			names:   []string{"assume_role_policy"},
+		},
+		"empty": {
+			prior:   null,
+			planned: value(""),
+			names:   []string{"assume_role_policy"},
+		},
+		"nested changed": {
+			prior:   value("a", inlinePolicy("x", "p1"), inlinePolicy("y", "p2")),
+			planned: value("a", inlinePolicy("x", "p1"), inlinePolicy("y", "p3")),
+			names:   []string{"inline_policy", "policy"},
+			wantPaths: []*tftypes.AttributePath{
+				tftypes.NewAttributePath().WithAttributeName("inline_policy").WithElementKeyValue(inlinePolicy("y", "p3")).WithAttributeName("policy"),
+			},
+		},
+		"nested unknown": {
+			prior:   null,
+			planned: value("a", inlinePolicy("x", tftypes.UnknownValue)),
+			names:   []string{"inline_policy", "policy"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			documents, err := changedPolicyDocuments(testCase.prior, testCase.planned, testCase.names)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, want := len(documents), len(testCase.wantPaths); got != want {
+				t.Fatalf("got %d documents, want %d", got, want)
+			}
+			for i, document := range documents {
+				if got, want := document.path, testCase.wantPaths[i]; !got.Equal(want) {
+					t.Errorf("path = %s, want %s", got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestPolicyAttributes(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	factory, _, err := ProtoV5ProviderServerFactory(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	response, err := factory().GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for typeName, attributes := range policyAttributes {
+		schema, ok := response.ResourceSchemas[typeName]
+		if !ok {
+			t.Errorf("%s: resource type not found", typeName)
+			continue
+		}
+
+		for _, attribute := range attributes {
+			if attribute.policyType == "" {
+				t.Errorf("%s: empty policy type", typeName)
+			}
+
+			typ := schema.ValueType()
+			for _, name := range attribute.path {
+				switch v := typ.(type) {
+				case tftypes.List:
+					typ = v.ElementType
+				case tftypes.Set:
+					typ = v.ElementType
+				}
+
+				objectType, ok := typ.(tftypes.Object)
+				if !ok {
+					typ = nil
+					break
+				}
+
+				typ = objectType.AttributeTypes[name]
+			}
+
+			if typ == nil || !typ.Is(tftypes.String) {
+				t.Errorf("%s: %v is not a string attribute", typeName, attribute.path)
+			}
+		}
+	}
+}
@@ -23,6 +23,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfencryption "github.com/hashicorp/terraform-provider-aws/internal/encryption"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
@@ -62,6 +63,36 @@ func New(ctx context.Context) (*schema.Provider, error) {
 					"Can also be configured using the `AWS_CA_BUNDLE` environment variable. " +
 					"(Setting `ca_bundle` in the shared config file is not supported.)",
 			},
+			"default_encryption": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration block with settings to default resource encryption across all resources.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrKMSKeyARN: {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidARN,
+							Description:  "ARN of the KMS key used to encrypt resources that support KMS keys when no key is configured.",
+						},
+						"kms_key_arns": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: verify.ValidARN,
+							},
+							Description: "ARNs of service-specific KMS keys, keyed by service name. Overrides `kms_key_arn`.",
+						},
+						"require_encryption": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Description: "Fail plan for resources explicitly configured unencrypted.",
+						},
+					},
+				},
+			},
 			"default_tags": {
 				Type:        schema.TypeList,
 				Optional:    true,
@@ -110,6 +141,12 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "URL of a proxy to use for HTTPS requests when accessing the AWS API. " +
 					"Can also be set using the `HTTPS_PROXY` or `https_proxy` environment variables.",
 			},
+			"iam_policy_validation": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Description: "Validate IAM policy arguments during plan using IAM Access Analyzer. " +
+					"Errors are reported as errors and security warnings as warnings.",
+			},
 			"ignore_tags": {
 				Type:        schema.TypeList,
 				Optional:    true,
@@ -153,6 +190,24 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "Comma-separated list of hosts that should not use HTTP or HTTPS proxies. " +
 					"Can also be set using the `NO_PROXY` or `no_proxy` environment variables.",
 			},
+			"offline": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Description: "Run without AWS access. Credentials validation and account ID lookup are skipped " +
+					"and read-only AWS API calls are answered from the fixtures in `offline_fixtures_path`.",
+			},
+			"offline_fixtures_path": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Path to the directory containing the AWS API response fixtures used in offline mode.",
+			},
+			"permission_preflight": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Description: "Check during plan that the caller has the IAM permissions required by planned changes, " +
+					"using the IAM policy simulator. Denied actions are reported as warnings. " +
+					"Only the resource types listed in the provider documentation are checked.",
+			},
 			"profile": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -396,6 +451,27 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				})
 			}
 
+			if spec, ok := defaultEncryptionResourceSpecs[typeName]; ok {
+				schemaMap := r.SchemaMap()
+
+				// The default KMS key is planned by CustomizeDiff, which can only set Computed attributes.
+				var computedAttrs []string
+				if spec.block == "" {
+					attrs := []string{spec.kmsKeyAttribute}
+					if spec.setEncrypted {
+						attrs = append(attrs, spec.encryptedAttribute)
+					}
+					for _, attr := range attrs {
+						if v, ok := schemaMap[attr]; ok && !v.Computed {
+							v.Computed = true
+							computedAttrs = append(computedAttrs, attr)
+						}
+					}
+				}
+
+				customizeDiffFuncs = append(customizeDiffFuncs, requireEncryption(spec), defaultEncryption(servicePackageName, spec, computedAttrs))
+			}
+
 			opts := wrappedResourceOptions{
 				// bootstrapContext is run on all wrapped methods before any interceptors.
 				bootstrapContext: func(ctx context.Context, _ getAttributeFunc, meta any) (context.Context, diag.Diagnostics) {
@@ -454,8 +530,12 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		EC2MetadataServiceEndpoint:     d.Get("ec2_metadata_service_endpoint").(string),
 		EC2MetadataServiceEndpointMode: d.Get("ec2_metadata_service_endpoint_mode").(string),
 		Endpoints:                      make(map[string]string),
+		IAMPolicyValidation:            d.Get("iam_policy_validation").(bool),
 		Insecure:                       d.Get("insecure").(bool),
 		MaxRetries:                     25, // Set default here, not in schema (muxing with v6 provider).
+		Offline:                        d.Get("offline").(bool),
+		OfflineFixturesPath:            d.Get("offline_fixtures_path").(string),
+		PermissionPreflight:            d.Get("permission_preflight").(bool),
 		Profile:                        d.Get("profile").(string),
 		Region:                         d.Get("region").(string),
 		S3UsePathStyle:                 d.Get("s3_use_path_style").(bool),
@@ -471,6 +551,10 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		UseFIPSEndpoint:                d.Get("use_fips_endpoint").(bool),
 	}
 
+	if config.Offline && config.OfflineFixturesPath == "" {
+		return nil, sdkdiag.AppendErrorf(diags, "`offline_fixtures_path` must be set when `offline` is true")
+	}
+
 	if v, ok := d.Get("retry_mode").(string); ok && v != "" {
 		mode, err := aws.ParseRetryMode(v)
 		if err != nil {
@@ -528,6 +612,10 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		})
 	}
 
+	if v, ok := d.GetOk("default_encryption"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
+		config.DefaultEncryptionConfig = expandDefaultEncryption(v.([]any)[0].(map[string]any))
+	}
+
 	if v, ok := d.GetOk("default_tags"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
 		config.DefaultTagsConfig = expandDefaultTags(ctx, v.([]any)[0].(map[string]any))
 	} else {
@@ -839,6 +927,28 @@ func expandAssumeRoleWithWebIdentity(_ context.Context, tfMap map[string]any) *a
 	return &assumeRole
 }
 
+func expandDefaultEncryption(tfMap map[string]any) *tfencryption.DefaultConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	config := &tfencryption.DefaultConfig{}
+
+	if v, ok := tfMap[names.AttrKMSKeyARN].(string); ok {
+		config.KMSKeyARN = v
+	}
+
+	if v, ok := tfMap["kms_key_arns"].(map[string]any); ok && len(v) > 0 {
+		config.KMSKeyARNs = flex.ExpandStringValueMap(v)
+	}
+
+	if v, ok := tfMap["require_encryption"].(bool); ok {
+		config.RequireEncryption = v
+	}
+
+	return config
+}
+
 func expandDefaultTags(ctx context.Context, tfMap map[string]any) *tftags.DefaultConfig {
 	tags := make(map[string]any)
 	for _, ev := range os.Environ() {
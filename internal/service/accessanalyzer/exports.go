@@ -0,0 +1,10 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package accessanalyzer
+
+// Exports for use in other modules.
+var (
+	FindPolicyValidationFindings = findPolicyValidationFindings
+	PolicyLocationPath           = policyLocationPath
+)
@@ -0,0 +1,287 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package accessanalyzer
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/accessanalyzer"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/accessanalyzer/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_accessanalyzer_policy_check", name="Policy Check")
+func newPolicyCheckDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &policyCheckDataSource{}, nil
+}
+
+type policyCheckDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *policyCheckDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrMessage: schema.StringAttribute{
+				Computed: true,
+			},
+			"policy_document": schema.StringAttribute{
+				CustomType: fwtypes.IAMPolicyType,
+				Required:   true,
+			},
+			"policy_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.AccessCheckPolicyType](),
+				Optional:   true,
+			},
+			"reasons": framework.DataSourceComputedListOfObjectAttribute[policyCheckReasonModel](ctx),
+			"result": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"access_not_granted": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[accessNotGrantedModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"access": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[accessModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtLeast(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrActions: schema.SetAttribute{
+										CustomType:  fwtypes.SetOfStringType,
+										ElementType: types.StringType,
+										Optional:    true,
+									},
+									names.AttrResources: schema.SetAttribute{
+										CustomType:  fwtypes.SetOfStringType,
+										ElementType: types.StringType,
+										Optional:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"no_new_access": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[noNewAccessModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"existing_policy_document": schema.StringAttribute{
+							CustomType: fwtypes.IAMPolicyType,
+							Required:   true,
+						},
+					},
+				},
+			},
+			"no_public_access": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[noPublicAccessModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrResourceType: schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.AccessCheckResourceType](),
+							Required:   true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *policyCheckDataSource) ValidateConfig(ctx context.Context, request datasource.ValidateConfigRequest, response *datasource.ValidateConfigResponse) {
+	var data policyCheckDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if data.AccessNotGranted.IsUnknown() || data.NoNewAccess.IsUnknown() || data.NoPublicAccess.IsUnknown() {
+		return
+	}
+
+	var n int
+	for _, v := range []int{len(data.AccessNotGranted.Elements()), len(data.NoNewAccess.Elements()), len(data.NoPublicAccess.Elements())} {
+		n += v
+	}
+
+	if n != 1 {
+		response.Diagnostics.AddError("Invalid Attribute Combination", "Exactly one of access_not_granted, no_new_access or no_public_access must be configured.")
+
+		return
+	}
+
+	if len(data.NoPublicAccess.Elements()) == 0 && data.PolicyType.IsNull() {
+		response.Diagnostics.AddAttributeError(path.Root("policy_type"), "Missing Required Argument", "policy_type must be configured for the access_not_granted and no_new_access checks.")
+	}
+}
+
+func (d *policyCheckDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data policyCheckDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().AccessAnalyzerClient(ctx)
+
+	var reasons []awstypes.ReasonSummary
+
+	switch {
+	case len(data.AccessNotGranted.Elements()) > 0:
+		accessNotGranted, diags := data.AccessNotGranted.ToPtr(ctx)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		input := accessanalyzer.CheckAccessNotGrantedInput{
+			PolicyDocument: fwflex.StringFromFramework(ctx, data.PolicyDocument),
+			PolicyType:     data.PolicyType.ValueEnum(),
+		}
+		response.Diagnostics.Append(fwflex.Expand(ctx, accessNotGranted.Access, &input.Access)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		output, err := conn.CheckAccessNotGranted(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError("checking IAM Access Analyzer policy access not granted", err.Error())
+
+			return
+		}
+
+		data.Message = fwflex.StringToFramework(ctx, output.Message)
+		data.Result = fwflex.StringValueToFramework(ctx, output.Result)
+		reasons = output.Reasons
+
+	case len(data.NoNewAccess.Elements()) > 0:
+		noNewAccess, diags := data.NoNewAccess.ToPtr(ctx)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		input := accessanalyzer.CheckNoNewAccessInput{
+			ExistingPolicyDocument: fwflex.StringFromFramework(ctx, noNewAccess.ExistingPolicyDocument),
+			NewPolicyDocument:      fwflex.StringFromFramework(ctx, data.PolicyDocument),
+			PolicyType:             data.PolicyType.ValueEnum(),
+		}
+
+		output, err := conn.CheckNoNewAccess(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError("checking IAM Access Analyzer policy no new access", err.Error())
+
+			return
+		}
+
+		data.Message = fwflex.StringToFramework(ctx, output.Message)
+		data.Result = fwflex.StringValueToFramework(ctx, output.Result)
+		reasons = output.Reasons
+
+	case len(data.NoPublicAccess.Elements()) > 0:
+		noPublicAccess, diags := data.NoPublicAccess.ToPtr(ctx)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		input := accessanalyzer.CheckNoPublicAccessInput{
+			PolicyDocument: fwflex.StringFromFramework(ctx, data.PolicyDocument),
+			ResourceType:   noPublicAccess.ResourceType.ValueEnum(),
+		}
+
+		output, err := conn.CheckNoPublicAccess(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError("checking IAM Access Analyzer policy no public access", err.Error())
+
+			return
+		}
+
+		data.Message = fwflex.StringToFramework(ctx, output.Message)
+		data.Result = fwflex.StringValueToFramework(ctx, output.Result)
+		reasons = output.Reasons
+	}
+
+	data.Reasons = fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, flattenReasonSummaries(ctx, reasons))
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func flattenReasonSummaries(ctx context.Context, apiObjects []awstypes.ReasonSummary) []policyCheckReasonModel {
+	reasons := make([]policyCheckReasonModel, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		reasons = append(reasons, policyCheckReasonModel{
+			Description:    fwflex.StringToFramework(ctx, apiObject.Description),
+			StatementID:    fwflex.StringToFramework(ctx, apiObject.StatementId),
+			StatementIndex: fwflex.Int32ToFrameworkInt64(ctx, apiObject.StatementIndex),
+		})
+	}
+
+	return reasons
+}
+
+type policyCheckDataSourceModel struct {
+	AccessNotGranted fwtypes.ListNestedObjectValueOf[accessNotGrantedModel]  `tfsdk:"access_not_granted"`
+	Message          types.String                                            `tfsdk:"message"`
+	NoNewAccess      fwtypes.ListNestedObjectValueOf[noNewAccessModel]       `tfsdk:"no_new_access"`
+	NoPublicAccess   fwtypes.ListNestedObjectValueOf[noPublicAccessModel]    `tfsdk:"no_public_access"`
+	PolicyDocument   fwtypes.IAMPolicy                                       `tfsdk:"policy_document"`
+	PolicyType       fwtypes.StringEnum[awstypes.AccessCheckPolicyType]      `tfsdk:"policy_type"`
+	Reasons          fwtypes.ListNestedObjectValueOf[policyCheckReasonModel] `tfsdk:"reasons"`
+	Result           types.String                                            `tfsdk:"result"`
+}
+
+type accessNotGrantedModel struct {
+	Access fwtypes.ListNestedObjectValueOf[accessModel] `tfsdk:"access"`
+}
+
+type accessModel struct {
+	Actions   fwtypes.SetOfString `tfsdk:"actions"`
+	Resources fwtypes.SetOfString `tfsdk:"resources"`
+}
+
+type noNewAccessModel struct {
+	ExistingPolicyDocument fwtypes.IAMPolicy `tfsdk:"existing_policy_document"`
+}
+
+type noPublicAccessModel struct {
+	ResourceType fwtypes.StringEnum[awstypes.AccessCheckResourceType] `tfsdk:"resource_type"`
+}
+
+type policyCheckReasonModel struct {
+	Description    types.String `tfsdk:"description"`
+	StatementID    types.String `tfsdk:"statement_id"`
+	StatementIndex types.Int64  `tfsdk:"statement_index"`
+}
+
+var (
+	_ datasource.DataSourceWithValidateConfig = &policyCheckDataSource{}
+)
@@ -0,0 +1,194 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package accessanalyzer_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccAccessAnalyzerPolicyCheckDataSource_accessNotGranted(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_accessanalyzer_policy_check.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AccessAnalyzerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyCheckDataSourceConfig_accessNotGranted,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "result", "FAIL"),
+					resource.TestCheckResourceAttrSet(dataSourceName, names.AttrMessage),
+					resource.TestCheckResourceAttr(dataSourceName, "reasons.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "reasons.0.statement_index", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAccessAnalyzerPolicyCheckDataSource_noNewAccess(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_accessanalyzer_policy_check.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AccessAnalyzerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyCheckDataSourceConfig_noNewAccess,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "result", "PASS"),
+					resource.TestCheckResourceAttr(dataSourceName, "reasons.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAccessAnalyzerPolicyCheckDataSource_noPublicAccess(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_accessanalyzer_policy_check.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AccessAnalyzerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyCheckDataSourceConfig_noPublicAccess,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "result", "FAIL"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "reasons.0.description"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAccessAnalyzerPolicyCheckDataSource_invalidConfig(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AccessAnalyzerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccPolicyCheckDataSourceConfig_noCheck,
+				ExpectError: regexp.MustCompile(`Exactly one of access_not_granted, no_new_access or no_public_access`),
+			},
+			{
+				Config:      testAccPolicyCheckDataSourceConfig_noPolicyType,
+				ExpectError: regexp.MustCompile(`policy_type must be configured`),
+			},
+		},
+	})
+}
+
+const testAccPolicyCheckDataSourceConfig_accessNotGranted = `
+data "aws_accessanalyzer_policy_check" "test" {
+  policy_type = "IDENTITY_POLICY"
+
+  policy_document = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect   = "Allow"
+      Action   = "s3:*"
+      Resource = "*"
+    }]
+  })
+
+  access_not_granted {
+    access {
+      actions = ["s3:DeleteBucket"]
+    }
+  }
+}
+`
+
+const testAccPolicyCheckDataSourceConfig_noNewAccess = `
+data "aws_accessanalyzer_policy_check" "test" {
+  policy_type = "IDENTITY_POLICY"
+
+  policy_document = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect   = "Allow"
+      Action   = "s3:GetObject"
+      Resource = "arn:aws:s3:::example-bucket/*"
+    }]
+  })
+
+  no_new_access {
+    existing_policy_document = jsonencode({
+      Version = "2012-10-17"
+      Statement = [{
+        Effect   = "Allow"
+        Action   = "s3:Get*"
+        Resource = "arn:aws:s3:::example-bucket/*"
+      }]
+    })
+  }
+}
+`
+
+const testAccPolicyCheckDataSourceConfig_noPublicAccess = `
+data "aws_accessanalyzer_policy_check" "test" {
+  policy_document = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect    = "Allow"
+      Principal = "*"
+      Action    = "s3:GetObject"
+      Resource  = "arn:aws:s3:::example-bucket/*"
+    }]
+  })
+
+  no_public_access {
+    resource_type = "AWS::S3::Bucket"
+  }
+}
+`
+
+const testAccPolicyCheckDataSourceConfig_noCheck = `
+data "aws_accessanalyzer_policy_check" "test" {
+  policy_type = "IDENTITY_POLICY"
+
+  policy_document = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect   = "Allow"
+      Action   = "s3:GetObject"
+      Resource = "*"
+    }]
+  })
+}
+`
+
+const testAccPolicyCheckDataSourceConfig_noPolicyType = `
+data "aws_accessanalyzer_policy_check" "test" {
+  policy_document = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect   = "Allow"
+      Action   = "s3:GetObject"
+      Resource = "*"
+    }]
+  })
+
+  access_not_granted {
+    access {
+      actions = ["s3:DeleteBucket"]
+    }
+  }
+}
+`
@@ -0,0 +1,159 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package accessanalyzer
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/accessanalyzer"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/accessanalyzer/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+)
+
+// @FrameworkDataSource("aws_accessanalyzer_policy_validation", name="Policy Validation")
+func newPolicyValidationDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &policyValidationDataSource{}, nil
+}
+
+type policyValidationDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *policyValidationDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"findings": framework.DataSourceComputedListOfObjectAttribute[policyValidationFindingModel](ctx),
+			"locale": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.Locale](),
+				Optional:   true,
+			},
+			"policy_document": schema.StringAttribute{
+				CustomType: fwtypes.IAMPolicyType,
+				Required:   true,
+			},
+			"policy_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.PolicyType](),
+				Required:   true,
+			},
+			"validate_policy_resource_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ValidatePolicyResourceType](),
+				Optional:   true,
+			},
+		},
+	}
+}
+
+func (d *policyValidationDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data policyValidationDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().AccessAnalyzerClient(ctx)
+
+	var input accessanalyzer.ValidatePolicyInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	findings, err := findPolicyValidationFindings(ctx, conn, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError("validating IAM Access Analyzer policy", err.Error())
+
+		return
+	}
+
+	data.Findings = fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, flattenValidatePolicyFindings(ctx, findings))
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func findPolicyValidationFindings(ctx context.Context, conn *accessanalyzer.Client, input *accessanalyzer.ValidatePolicyInput) ([]awstypes.ValidatePolicyFinding, error) {
+	var output []awstypes.ValidatePolicyFinding
+
+	pages := accessanalyzer.NewValidatePolicyPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Findings...)
+	}
+
+	return output, nil
+}
+
+func flattenValidatePolicyFindings(ctx context.Context, apiObjects []awstypes.ValidatePolicyFinding) []policyValidationFindingModel {
+	findings := make([]policyValidationFindingModel, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		locations := make([]string, 0, len(apiObject.Locations))
+		for _, v := range apiObject.Locations {
+			locations = append(locations, policyLocationPath(v.Path))
+		}
+
+		findings = append(findings, policyValidationFindingModel{
+			FindingDetails: fwflex.StringToFramework(ctx, apiObject.FindingDetails),
+			FindingType:    fwtypes.StringEnumValue(apiObject.FindingType),
+			IssueCode:      fwflex.StringToFramework(ctx, apiObject.IssueCode),
+			LearnMoreLink:  fwflex.StringToFramework(ctx, apiObject.LearnMoreLink),
+			Locations:      fwflex.FlattenFrameworkStringValueListOfString(ctx, locations),
+		})
+	}
+
+	return findings
+}
+
+// policyLocationPath returns a policy location path as a string, e.g. `Statement[0].Condition.StringEquals["aws:SourceAccount"]`.
+func policyLocationPath(apiObjects []awstypes.PathElement) string {
+	var sb strings.Builder
+
+	for _, apiObject := range apiObjects {
+		switch v := apiObject.(type) {
+		case *awstypes.PathElementMemberIndex:
+			fmt.Fprintf(&sb, "[%d]", v.Value)
+		case *awstypes.PathElementMemberKey:
+			if sb.Len() > 0 {
+				sb.WriteString(".")
+			}
+			sb.WriteString(v.Value)
+		case *awstypes.PathElementMemberSubstring:
+			start, length := aws.ToInt32(v.Value.Start), aws.ToInt32(v.Value.Length)
+			fmt.Fprintf(&sb, "[%d:%d]", start, start+length)
+		case *awstypes.PathElementMemberValue:
+			fmt.Fprintf(&sb, "[%q]", v.Value)
+		}
+	}
+
+	return sb.String()
+}
+
+type policyValidationDataSourceModel struct {
+	Findings                   fwtypes.ListNestedObjectValueOf[policyValidationFindingModel] `tfsdk:"findings" autoflex:"-"`
+	Locale                     fwtypes.StringEnum[awstypes.Locale]                           `tfsdk:"locale"`
+	PolicyDocument             fwtypes.IAMPolicy                                             `tfsdk:"policy_document"`
+	PolicyType                 fwtypes.StringEnum[awstypes.PolicyType]                       `tfsdk:"policy_type"`
+	ValidatePolicyResourceType fwtypes.StringEnum[awstypes.ValidatePolicyResourceType]       `tfsdk:"validate_policy_resource_type"`
+}
+
+type policyValidationFindingModel struct {
+	FindingDetails types.String                                           `tfsdk:"finding_details"`
+	FindingType    fwtypes.StringEnum[awstypes.ValidatePolicyFindingType] `tfsdk:"finding_type"`
+	IssueCode      types.String                                           `tfsdk:"issue_code"`
+	LearnMoreLink  types.String                                           `tfsdk:"learn_more_link"`
+	Locations      fwtypes.ListValueOf[types.String]                      `tfsdk:"locations"`
+}
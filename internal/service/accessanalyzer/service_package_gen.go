@@ -15,7 +15,18 @@ import (
 type servicePackage struct{}
 
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.ServicePackageFrameworkDataSource {
-	return []*types.ServicePackageFrameworkDataSource{}
+	return []*types.ServicePackageFrameworkDataSource{
+		{
+			Factory:  newPolicyCheckDataSource,
+			TypeName: "aws_accessanalyzer_policy_check",
+			Name:     "Policy Check",
+		},
+		{
+			Factory:  newPolicyValidationDataSource,
+			TypeName: "aws_accessanalyzer_policy_validation",
+			Name:     "Policy Validation",
+		},
+	}
 }
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.ServicePackageFrameworkResource {
@@ -10,14 +10,18 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
 	"github.com/aws/aws-sdk-go-v2/service/acm"
 	"github.com/aws/aws-sdk-go-v2/service/acm/types"
+	"github.com/aws/aws-sdk-go-v2/service/route53"
+	route53types "github.com/aws/aws-sdk-go-v2/service/route53/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
@@ -31,6 +35,8 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	sdktypes "github.com/hashicorp/terraform-provider-aws/internal/sdkv2/types"
+	tfroute53 "github.com/hashicorp/terraform-provider-aws/internal/service/route53"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/types/duration"
@@ -51,6 +57,11 @@ const (
 	CertificateRenewalTimeout = 1 * time.Minute
 
 	certificateValidationMethodNone = "NONE"
+
+	// Maximum amount of time for removal of Route 53 DNS validation records.
+	certificateRoute53ValidationRecordDeleteTimeout = 10 * time.Minute
+
+	certificateRoute53ValidationRecordTTL = 60
 )
 
 // @SDKResource("aws_acm_certificate", name="Certificate")
@@ -67,6 +78,11 @@ func resourceCertificate() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(75 * time.Minute),
+			Update: schema.DefaultTimeout(75 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			names.AttrARN: {
 				Type:     schema.TypeString,
@@ -197,6 +213,38 @@ func resourceCertificate() *schema.Resource {
 					},
 				},
 			},
+			"route53_validation": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrRoleARN: {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"zone": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrDomainName: {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringDoesNotMatch(regexache.MustCompile(`\.$`), "cannot end with a period"),
+									},
+									"zone_id": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+				ConflictsWith: []string{"certificate_authority_arn", "certificate_body", names.AttrCertificateChain, names.AttrPrivateKey, "validation_option"},
+			},
 			names.AttrStatus: {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -322,6 +370,17 @@ func resourceCertificate() *schema.Resource {
 					}
 				}
 
+				return nil
+			},
+			func(_ context.Context, diff *schema.ResourceDiff, _ any) error {
+				if v := diff.Get("route53_validation").([]any); len(v) == 0 || !diff.NewValueKnown("validation_method") {
+					return nil
+				}
+
+				if v := diff.Get("validation_method").(string); v != string(types.ValidationMethodDns) {
+					return fmt.Errorf("route53_validation requires validation_method to be %s", types.ValidationMethodDns)
+				}
+
 				return nil
 			},
 		),
@@ -403,6 +462,26 @@ func resourceCertificateCreate(ctx context.Context, d *schema.ResourceData, meta
 		return sdkdiag.AppendErrorf(diags, "waiting for ACM Certificate (%s) to be issued: %s", d.Id(), err)
 	}
 
+	if v := d.Get("route53_validation").([]any); len(v) > 0 {
+		tfMap, _ := v[0].(map[string]any)
+
+		certificate, err := waitCertificateDomainValidationRecordsAvailable(ctx, conn, d.Id(), certificateDNSValidationAssignmentTimeout)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for ACM Certificate (%s) DNS validation records: %s", d.Id(), err)
+		}
+
+		records := certificateValidationRecordsFromDomainValidations(certificate.DomainValidationOptions)
+
+		if _, err := upsertCertificateRoute53ValidationRecords(ctx, meta.(*conns.AWSClient), tfMap, records, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating ACM Certificate (%s) Route 53 DNS validation records: %s", d.Id(), err)
+		}
+
+		if _, err := waitCertificateIssued(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for ACM Certificate (%s) to be issued: %s", d.Id(), err)
+		}
+	}
+
 	return append(diags, resourceCertificateRead(ctx, d, meta)...)
 }
 
@@ -533,6 +612,36 @@ func resourceCertificateUpdate(ctx context.Context, d *schema.ResourceData, meta
 		}
 	}
 
+	if d.HasChange("route53_validation") {
+		o, n := d.GetChange("route53_validation")
+		records := certificateValidationRecordsFromDomainValidationOptions(d.Get("domain_validation_options").(*schema.Set).List())
+
+		var hostedZoneRecords map[string][]certificateValidationRecord
+		if v := n.([]any); len(v) > 0 {
+			tfMap, _ := v[0].(map[string]any)
+
+			var err error
+			hostedZoneRecords, err = upsertCertificateRoute53ValidationRecords(ctx, meta.(*conns.AWSClient), tfMap, records, d.Timeout(schema.TimeoutUpdate))
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating ACM Certificate (%s) Route 53 DNS validation records: %s", d.Id(), err)
+			}
+
+			if _, err := waitCertificateIssued(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return sdkdiag.AppendErrorf(diags, "waiting for ACM Certificate (%s) to be issued: %s", d.Id(), err)
+			}
+		}
+
+		if v := o.([]any); len(v) > 0 {
+			tfMap, _ := v[0].(map[string]any)
+
+			// Only remove records which are no longer managed in the same hosted zone.
+			if err := deleteCertificateRoute53ValidationRecords(ctx, meta.(*conns.AWSClient), tfMap, records, hostedZoneRecords); err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating ACM Certificate (%s) Route 53 DNS validation records: %s", d.Id(), err)
+			}
+		}
+	}
+
 	return append(diags, resourceCertificateRead(ctx, d, meta)...)
 }
 
@@ -551,13 +660,35 @@ func resourceCertificateDelete(ctx context.Context, d *schema.ResourceData, meta
 		})
 
 	if errs.IsA[*types.ResourceNotFoundException](err) {
-		return diags
+		err = nil
 	}
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "deleting ACM Certificate (%s): %s", d.Id(), err)
 	}
 
+	if v := d.Get("route53_validation").([]any); len(v) > 0 {
+		tfMap, _ := v[0].(map[string]any)
+		records := certificateValidationRecordsFromDomainValidationOptions(d.Get("domain_validation_options").(*schema.Set).List())
+
+		// ACM uses the same DNS validation record for a domain name in all of an account's certificates,
+		// so records still required by other certificates, e.g. a replacement certificate, are retained.
+		domainNames, err := findCertificateValidationDomainNamesInUse(ctx, conn, d.Id())
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "listing ACM Certificates: %s", err)
+		}
+
+		records = slices.DeleteFunc(records, func(v certificateValidationRecord) bool {
+			_, ok := domainNames[certificateValidationDomainName(v.domainName)]
+			return ok
+		})
+
+		if err := deleteCertificateRoute53ValidationRecords(ctx, meta.(*conns.AWSClient), tfMap, records, nil); err != nil {
+			return sdkdiag.AppendErrorf(diags, "deleting ACM Certificate (%s) Route 53 DNS validation records: %s", d.Id(), err)
+		}
+	}
+
 	return diags
 }
 
@@ -612,6 +743,239 @@ func certificateSetPendingRenewal(d resourceGetter) bool {
 	return time.Now().After(earlyExpiration)
 }
 
+type certificateValidationRecord struct {
+	domainName string
+	name       string
+	recordType string
+	value      string
+}
+
+// certificateValidationDomainName returns the domain name used to match a certificate's domain to a hosted zone.
+// A wildcard domain name and its base domain name share the same DNS validation record.
+func certificateValidationDomainName(domainName string) string {
+	return strings.ToLower(strings.TrimPrefix(strings.TrimSuffix(domainName, "."), "*."))
+}
+
+func certificateValidationRecordsFromDomainValidations(apiObjects []types.DomainValidation) []certificateValidationRecord {
+	var records []certificateValidationRecord
+
+	for _, apiObject := range apiObjects {
+		if v := apiObject.ResourceRecord; v != nil {
+			records = append(records, certificateValidationRecord{
+				domainName: aws.ToString(apiObject.DomainName),
+				name:       aws.ToString(v.Name),
+				recordType: string(v.Type),
+				value:      aws.ToString(v.Value),
+			})
+		}
+	}
+
+	return uniqueCertificateValidationRecords(records)
+}
+
+func certificateValidationRecordsFromDomainValidationOptions(tfList []any) []certificateValidationRecord {
+	var records []certificateValidationRecord
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]any)
+
+		if !ok {
+			continue
+		}
+
+		if v, ok := tfMap["resource_record_name"].(string); ok && v != "" {
+			records = append(records, certificateValidationRecord{
+				domainName: tfMap[names.AttrDomainName].(string),
+				name:       v,
+				recordType: tfMap["resource_record_type"].(string),
+				value:      tfMap["resource_record_value"].(string),
+			})
+		}
+	}
+
+	return uniqueCertificateValidationRecords(records)
+}
+
+func uniqueCertificateValidationRecords(records []certificateValidationRecord) []certificateValidationRecord {
+	seen := make(map[string]struct{})
+
+	return slices.DeleteFunc(records, func(v certificateValidationRecord) bool {
+		name := strings.ToLower(strings.TrimSuffix(v.name, "."))
+
+		if _, ok := seen[name]; ok {
+			return true
+		}
+
+		seen[name] = struct{}{}
+
+		return false
+	})
+}
+
+// certificateRoute53ValidationClient returns a Route 53 client, assuming any configured IAM role for hosted zones in other accounts.
+func certificateRoute53ValidationClient(ctx context.Context, c *conns.AWSClient, tfMap map[string]any) *route53.Client {
+	conn := c.Route53Client(ctx)
+
+	if v, ok := tfMap[names.AttrRoleARN].(string); ok && v != "" {
+		return route53.New(conn.Options(), func(o *route53.Options) {
+			o.Credentials = aws.NewCredentialsCache(stscreds.NewAssumeRoleProvider(c.STSClient(ctx), v))
+		})
+	}
+
+	return conn
+}
+
+func expandCertificateRoute53ValidationZones(tfMap map[string]any) map[string]string {
+	hostedZoneIDs := make(map[string]string)
+
+	if v, ok := tfMap["zone"].(*schema.Set); ok {
+		for _, tfMapRaw := range v.List() {
+			tfMap, ok := tfMapRaw.(map[string]any)
+
+			if !ok {
+				continue
+			}
+
+			hostedZoneIDs[certificateValidationDomainName(tfMap[names.AttrDomainName].(string))] = tfMap["zone_id"].(string)
+		}
+	}
+
+	return hostedZoneIDs
+}
+
+// findCertificateValidationHostedZoneID returns the ID of the hosted zone for the specified domain name.
+// The configured zone with the longest matching domain name is used, otherwise the public hosted zone with the longest matching name is looked up.
+// Looked up hosted zone IDs are added to hostedZoneIDs.
+func findCertificateValidationHostedZoneID(ctx context.Context, conn *route53.Client, hostedZoneIDs map[string]string, domainName string) (string, error) {
+	domainName = certificateValidationDomainName(domainName)
+
+	var hostedZoneDomainName string
+	for k := range hostedZoneIDs {
+		if (domainName == k || strings.HasSuffix(domainName, "."+k)) && len(k) > len(hostedZoneDomainName) {
+			hostedZoneDomainName = k
+		}
+	}
+
+	if hostedZoneDomainName != "" {
+		return hostedZoneIDs[hostedZoneDomainName], nil
+	}
+
+	hostedZoneID, err := tfroute53.FindPublicHostedZoneIDByLongestMatchingDomainName(ctx, conn, domainName)
+
+	if err != nil {
+		return "", fmt.Errorf("finding Route 53 public Hosted Zone for %s: %w", domainName, err)
+	}
+
+	hostedZoneIDs[domainName] = aws.ToString(hostedZoneID)
+
+	return hostedZoneIDs[domainName], nil
+}
+
+// upsertCertificateRoute53ValidationRecords creates or updates the DNS validation records, returning the records in each hosted zone.
+func upsertCertificateRoute53ValidationRecords(ctx context.Context, c *conns.AWSClient, tfMap map[string]any, records []certificateValidationRecord, timeout time.Duration) (map[string][]certificateValidationRecord, error) {
+	conn := certificateRoute53ValidationClient(ctx, c, tfMap)
+	hostedZoneIDs := expandCertificateRoute53ValidationZones(tfMap)
+	hostedZoneRecords := make(map[string][]certificateValidationRecord)
+
+	for _, record := range records {
+		hostedZoneID, err := findCertificateValidationHostedZoneID(ctx, conn, hostedZoneIDs, record.domainName)
+
+		if err != nil {
+			return nil, err
+		}
+
+		hostedZoneRecords[hostedZoneID] = append(hostedZoneRecords[hostedZoneID], record)
+	}
+
+	for hostedZoneID, records := range hostedZoneRecords {
+		if err := changeCertificateValidationRecords(ctx, conn, route53types.ChangeActionUpsert, hostedZoneID, records, timeout); err != nil {
+			return nil, err
+		}
+	}
+
+	return hostedZoneRecords, nil
+}
+
+// deleteCertificateRoute53ValidationRecords deletes the DNS validation records, except those in retain.
+// Records in hosted zones that no longer exist are ignored.
+func deleteCertificateRoute53ValidationRecords(ctx context.Context, c *conns.AWSClient, tfMap map[string]any, records []certificateValidationRecord, retain map[string][]certificateValidationRecord) error {
+	conn := certificateRoute53ValidationClient(ctx, c, tfMap)
+	hostedZoneIDs := expandCertificateRoute53ValidationZones(tfMap)
+	var deleteErr error
+
+	for _, record := range records {
+		hostedZoneID, err := findCertificateValidationHostedZoneID(ctx, conn, hostedZoneIDs, record.domainName)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			deleteErr = errors.Join(deleteErr, err)
+			continue
+		}
+
+		if slices.ContainsFunc(retain[hostedZoneID], func(v certificateValidationRecord) bool {
+			return strings.EqualFold(v.name, record.name)
+		}) {
+			continue
+		}
+
+		if err := changeCertificateValidationRecords(ctx, conn, route53types.ChangeActionDelete, hostedZoneID, []certificateValidationRecord{record}, certificateRoute53ValidationRecordDeleteTimeout); err != nil {
+			deleteErr = errors.Join(deleteErr, err)
+		}
+	}
+
+	return deleteErr
+}
+
+func changeCertificateValidationRecords(ctx context.Context, conn *route53.Client, action route53types.ChangeAction, hostedZoneID string, records []certificateValidationRecord, timeout time.Duration) error {
+	input := route53.ChangeResourceRecordSetsInput{
+		ChangeBatch: &route53types.ChangeBatch{
+			Changes: tfslices.ApplyToAll(records, func(v certificateValidationRecord) route53types.Change {
+				return route53types.Change{
+					Action: action,
+					ResourceRecordSet: &route53types.ResourceRecordSet{
+						Name: aws.String(v.name),
+						ResourceRecords: []route53types.ResourceRecord{
+							{
+								Value: aws.String(v.value),
+							},
+						},
+						TTL:  aws.Int64(certificateRoute53ValidationRecordTTL),
+						Type: route53types.RRType(v.recordType),
+					},
+				}
+			}),
+			Comment: aws.String("Managed by Terraform"),
+		},
+		HostedZoneId: aws.String(hostedZoneID),
+	}
+
+	output, err := conn.ChangeResourceRecordSets(ctx, &input)
+
+	// The record does not exist or was modified outside of Terraform.
+	if action == route53types.ChangeActionDelete && errs.IsA[*route53types.InvalidChangeBatch](err) {
+		return nil
+	}
+
+	if v, ok := errs.As[*route53types.InvalidChangeBatch](err); ok && len(v.Messages) > 0 {
+		err = fmt.Errorf("%s: %w", v.ErrorCode(), errors.Join(tfslices.ApplyToAll(v.Messages, errors.New)...))
+	}
+
+	if err != nil {
+		return fmt.Errorf("changing Route 53 Hosted Zone (%s) records: %w", hostedZoneID, err)
+	}
+
+	if output.ChangeInfo != nil {
+		if _, err := tfroute53.WaitChangeInsync(ctx, conn, aws.ToString(output.ChangeInfo.Id), timeout); err != nil {
+			return fmt.Errorf("waiting for Route 53 Hosted Zone (%s) records synchronize: %w", hostedZoneID, err)
+		}
+	}
+
+	return nil
+}
+
 func expandCertificateOptions(tfMap map[string]any) *types.CertificateOptions {
 	if tfMap == nil {
 		return nil
@@ -819,6 +1183,37 @@ func findCertificateByARN(ctx context.Context, conn *acm.Client, arn string) (*t
 	return output, nil
 }
 
+// findCertificateValidationDomainNamesInUse returns the domain names of the account's other Amazon-issued certificates
+// which are issued or pending validation.
+func findCertificateValidationDomainNamesInUse(ctx context.Context, conn *acm.Client, arn string) (map[string]struct{}, error) {
+	input := acm.ListCertificatesInput{
+		CertificateStatuses: []types.CertificateStatus{types.CertificateStatusIssued, types.CertificateStatusPendingValidation},
+		Includes: &types.Filters{
+			KeyTypes: enum.EnumValues[types.KeyAlgorithm](),
+		},
+	}
+
+	certificates, err := findCertificates(ctx, conn, &input, func(v *types.CertificateSummary) bool {
+		return aws.ToString(v.CertificateArn) != arn && v.Type == types.CertificateTypeAmazonIssued
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	domainNames := make(map[string]struct{})
+
+	for _, certificate := range certificates {
+		domainNames[certificateValidationDomainName(aws.ToString(certificate.DomainName))] = struct{}{}
+
+		for _, v := range certificate.SubjectAlternativeNameSummaries {
+			domainNames[certificateValidationDomainName(v)] = struct{}{}
+		}
+	}
+
+	return domainNames, nil
+}
+
 func findCertificateRenewalByARN(ctx context.Context, conn *acm.Client, arn string) (*types.RenewalSummary, error) {
 	certificate, err := findCertificateByARN(ctx, conn, arn)
 
@@ -887,6 +1282,49 @@ func waitCertificateDomainValidationsAvailable(ctx context.Context, conn *acm.Cl
 	return nil, err
 }
 
+func statusCertificateDomainValidationRecordsAvailable(ctx context.Context, conn *acm.Client, arn string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		certificate, err := findCertificateByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		// ACM assigns DNS validation records to each domain name asynchronously.
+		domainValidationRecordsAvailable := len(certificate.DomainValidationOptions) > 0
+
+		for _, v := range certificate.DomainValidationOptions {
+			if v.ResourceRecord == nil && v.ValidationStatus != types.DomainStatusSuccess {
+				domainValidationRecordsAvailable = false
+
+				break
+			}
+		}
+
+		return certificate, strconv.FormatBool(domainValidationRecordsAvailable), nil
+	}
+}
+
+func waitCertificateDomainValidationRecordsAvailable(ctx context.Context, conn *acm.Client, arn string, timeout time.Duration) (*types.CertificateDetail, error) {
+	stateConf := &retry.StateChangeConf{
+		Target:  []string{strconv.FormatBool(true)},
+		Refresh: statusCertificateDomainValidationRecordsAvailable(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.CertificateDetail); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
 func statusCertificateRenewal(ctx context.Context, conn *acm.Client, arn string) retry.StateRefreshFunc {
 	return func() (any, string, error) {
 		output, err := findCertificateRenewalByARN(ctx, conn, arn)
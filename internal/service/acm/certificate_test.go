@@ -200,6 +200,90 @@ func TestAccACMCertificate_validationOptions(t *testing.T) {
 	})
 }
 
+func TestAccACMCertificate_Route53Validation_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_acm_certificate.test"
+	rootDomain := acctest.ACMCertificateDomainFromEnv(t)
+	domain := acctest.ACMCertificateRandomSubDomain(rootDomain)
+	wildcardDomain := fmt.Sprintf("*.%s", domain)
+	var v types.CertificateDetail
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ACMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCertificateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCertificateConfig_route53Validation(domain, wildcardDomain),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCertificateExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDomainName, domain),
+					resource.TestCheckResourceAttr(resourceName, "domain_validation_options.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "route53_validation.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "route53_validation.0.zone.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(types.CertificateStatusIssued)),
+					resource.TestCheckResourceAttr(resourceName, "validation_method", string(types.ValidationMethodDns)),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"route53_validation"},
+			},
+		},
+	})
+}
+
+func TestAccACMCertificate_Route53Validation_zone(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_acm_certificate.test"
+	rootDomain := acctest.ACMCertificateDomainFromEnv(t)
+	domain := acctest.ACMCertificateRandomSubDomain(rootDomain)
+	var v types.CertificateDetail
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ACMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCertificateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCertificateConfig_route53ValidationZone(rootDomain, domain),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCertificateExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "route53_validation.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "route53_validation.0.zone.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "route53_validation.0.zone.*", map[string]string{
+						names.AttrDomainName: rootDomain,
+					}),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(types.CertificateStatusIssued)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccACMCertificate_Route53Validation_emailValidationMethod(t *testing.T) {
+	ctx := acctest.Context(t)
+	rootDomain := acctest.ACMCertificateDomainFromEnv(t)
+	domain := acctest.ACMCertificateRandomSubDomain(rootDomain)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ACMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckCertificateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCertificateConfig_route53ValidationEmail(domain),
+				ExpectError: regexache.MustCompile(`route53_validation requires validation_method to be DNS`),
+			},
+		},
+	})
+}
+
 func TestAccACMCertificate_privateCertificate_renewable(t *testing.T) {
 	ctx := acctest.Context(t)
 	certificateAuthorityResourceName := "aws_acmpca_certificate_authority.test"
@@ -1833,6 +1917,50 @@ resource "aws_acm_certificate" "test" {
 `, rootDomainName, domainName)
 }
 
+func testAccCertificateConfig_route53Validation(domainName, subjectAlternativeName string) string {
+	return fmt.Sprintf(`
+resource "aws_acm_certificate" "test" {
+  domain_name               = %[1]q
+  subject_alternative_names = [%[2]q]
+  validation_method         = "DNS"
+
+  route53_validation {}
+}
+`, domainName, subjectAlternativeName)
+}
+
+func testAccCertificateConfig_route53ValidationZone(rootDomainName, domainName string) string {
+	return fmt.Sprintf(`
+data "aws_route53_zone" "test" {
+  name         = %[1]q
+  private_zone = false
+}
+
+resource "aws_acm_certificate" "test" {
+  domain_name       = %[2]q
+  validation_method = "DNS"
+
+  route53_validation {
+    zone {
+      domain_name = %[1]q
+      zone_id     = data.aws_route53_zone.test.zone_id
+    }
+  }
+}
+`, rootDomainName, domainName)
+}
+
+func testAccCertificateConfig_route53ValidationEmail(domainName string) string {
+	return fmt.Sprintf(`
+resource "aws_acm_certificate" "test" {
+  domain_name       = %[1]q
+  validation_method = "EMAIL"
+
+  route53_validation {}
+}
+`, domainName)
+}
+
 func testAccCertificateConfig_privateCertificateBase(commonName string) string {
 	return fmt.Sprintf(`
 resource "aws_acmpca_certificate_authority" "test" {
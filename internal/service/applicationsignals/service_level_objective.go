@@ -0,0 +1,1048 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package applicationsignals
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/applicationsignals"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/applicationsignals/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_applicationsignals_service_level_objective", name="Service Level Objective")
+// @Tags(identifierAttribute="arn")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/applicationsignals/types;awstypes;awstypes.ServiceLevelObjective")
+// @Testing(importStateIdAttribute="arn")
+func newServiceLevelObjectiveResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &serviceLevelObjectiveResource{}
+
+	return r, nil
+}
+
+type serviceLevelObjectiveResource struct {
+	framework.ResourceWithConfigure
+}
+
+func (r *serviceLevelObjectiveResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	metricDataQueryBlock := func() schema.ListNestedBlock {
+		return schema.ListNestedBlock{
+			CustomType: fwtypes.NewListNestedObjectTypeOf[metricDataQueryModel](ctx),
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					names.AttrAccountID: schema.StringAttribute{
+						Optional: true,
+					},
+					names.AttrExpression: schema.StringAttribute{
+						Optional: true,
+					},
+					names.AttrID: schema.StringAttribute{
+						Required: true,
+					},
+					"label": schema.StringAttribute{
+						Optional: true,
+					},
+					"period": schema.Int32Attribute{
+						Optional: true,
+						Validators: []validator.Int32{
+							int32validator.AtLeast(1),
+						},
+					},
+					"return_data": schema.BoolAttribute{
+						Optional: true,
+					},
+				},
+				Blocks: map[string]schema.Block{
+					"metric_stat": schema.ListNestedBlock{
+						CustomType: fwtypes.NewListNestedObjectTypeOf[metricStatModel](ctx),
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(1),
+						},
+						NestedObject: schema.NestedBlockObject{
+							Attributes: map[string]schema.Attribute{
+								"period": schema.Int32Attribute{
+									Required: true,
+									Validators: []validator.Int32{
+										int32validator.AtLeast(1),
+									},
+								},
+								"stat": schema.StringAttribute{
+									Required: true,
+								},
+								names.AttrUnit: schema.StringAttribute{
+									CustomType: fwtypes.StringEnumType[awstypes.StandardUnit](),
+									Optional:   true,
+								},
+							},
+							Blocks: map[string]schema.Block{
+								"metric": schema.ListNestedBlock{
+									CustomType: fwtypes.NewListNestedObjectTypeOf[metricModel](ctx),
+									Validators: []validator.List{
+										listvalidator.IsRequired(),
+										listvalidator.SizeAtLeast(1),
+										listvalidator.SizeAtMost(1),
+									},
+									NestedObject: schema.NestedBlockObject{
+										Attributes: map[string]schema.Attribute{
+											names.AttrMetricName: schema.StringAttribute{
+												Optional: true,
+											},
+											names.AttrNamespace: schema.StringAttribute{
+												Optional: true,
+											},
+										},
+										Blocks: map[string]schema.Block{
+											"dimension": schema.ListNestedBlock{
+												CustomType: fwtypes.NewListNestedObjectTypeOf[dimensionModel](ctx),
+												Validators: []validator.List{
+													listvalidator.SizeAtMost(30),
+												},
+												NestedObject: schema.NestedBlockObject{
+													Attributes: map[string]schema.Attribute{
+														names.AttrName: schema.StringAttribute{
+															Required: true,
+														},
+														names.AttrValue: schema.StringAttribute{
+															Required: true,
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+	durationAttributes := map[string]schema.Attribute{
+		names.AttrDuration: schema.Int32Attribute{
+			Required: true,
+			Validators: []validator.Int32{
+				int32validator.AtLeast(1),
+			},
+		},
+		"duration_unit": schema.StringAttribute{
+			CustomType: fwtypes.StringEnumType[awstypes.DurationUnit](),
+			Required:   true,
+		},
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 1024),
+				},
+			},
+			"evaluation_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.EvaluationType](),
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 128),
+					stringvalidator.RegexMatches(regexache.MustCompile(`^[0-9A-Za-z][0-9A-Za-z_.-]*$`), "must start with an alphanumeric character and contain only alphanumeric characters, underscores, periods and hyphens"),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"burn_rate_configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[burnRateConfigurationModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(10),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"look_back_window_minutes": schema.Int32Attribute{
+							Required: true,
+							Validators: []validator.Int32{
+								int32validator.Between(1, 10080),
+							},
+						},
+					},
+				},
+			},
+			"exclusion_window": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[exclusionWindowModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(10),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"reason": schema.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(1, 1024),
+							},
+						},
+						names.AttrStartTime: schema.StringAttribute{
+							CustomType: timetypes.RFC3339Type{},
+							Optional:   true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"recurrence_rule": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[recurrenceRuleModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrExpression: schema.StringAttribute{
+										Required: true,
+										Validators: []validator.String{
+											stringvalidator.LengthBetween(1, 1024),
+										},
+									},
+								},
+							},
+						},
+						"window": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[windowModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtLeast(1),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: durationAttributes,
+							},
+						},
+					},
+				},
+			},
+			"goal": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[goalModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"attainment_goal": schema.Float64Attribute{
+							Optional: true,
+							Computed: true,
+							PlanModifiers: []planmodifier.Float64{
+								float64planmodifier.UseStateForUnknown(),
+							},
+							Validators: []validator.Float64{
+								float64validator.Between(0, 100),
+							},
+						},
+						"warning_threshold": schema.Float64Attribute{
+							Optional: true,
+							Computed: true,
+							PlanModifiers: []planmodifier.Float64{
+								float64planmodifier.UseStateForUnknown(),
+							},
+							Validators: []validator.Float64{
+								float64validator.Between(0, 100),
+							},
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"interval": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[intervalModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtLeast(1),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Blocks: map[string]schema.Block{
+									"calendar_interval": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[calendarIntervalModel](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+											listvalidator.ExactlyOneOf(
+												path.MatchRelative().AtParent().AtName("rolling_interval"),
+											),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												names.AttrDuration: durationAttributes[names.AttrDuration],
+												"duration_unit":    durationAttributes["duration_unit"],
+												names.AttrStartTime: schema.StringAttribute{
+													CustomType: timetypes.RFC3339Type{},
+													Required:   true,
+												},
+											},
+										},
+									},
+									"rolling_interval": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[rollingIntervalModel](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+											listvalidator.ExactlyOneOf(
+												path.MatchRelative().AtParent().AtName("calendar_interval"),
+											),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: durationAttributes,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"request_based_sli": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[requestBasedSLIModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"comparison_operator": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.ServiceLevelIndicatorComparisonOperator](),
+							Optional:   true,
+						},
+						"metric_threshold": schema.Float64Attribute{
+							Optional: true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"request_based_sli_metric": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[requestBasedSLIMetricModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtLeast(1),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"key_attributes": schema.MapAttribute{
+										CustomType:  fwtypes.MapOfStringType,
+										ElementType: types.StringType,
+										Optional:    true,
+									},
+									"metric_type": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.ServiceLevelIndicatorMetricType](),
+										Optional:   true,
+									},
+									"operation_name": schema.StringAttribute{
+										Optional: true,
+									},
+								},
+								Blocks: map[string]schema.Block{
+									"monitored_request_count_metric": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[monitoredRequestCountMetricModel](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Blocks: map[string]schema.Block{
+												"bad_count_metric":  metricDataQueryBlock(),
+												"good_count_metric": metricDataQueryBlock(),
+											},
+										},
+									},
+									"total_request_count_metric": metricDataQueryBlock(),
+								},
+							},
+						},
+					},
+				},
+			},
+			"sli": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[sliModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"comparison_operator": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.ServiceLevelIndicatorComparisonOperator](),
+							Required:   true,
+						},
+						"metric_threshold": schema.Float64Attribute{
+							Required: true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"sli_metric": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[sliMetricModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtLeast(1),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"key_attributes": schema.MapAttribute{
+										CustomType:  fwtypes.MapOfStringType,
+										ElementType: types.StringType,
+										Optional:    true,
+									},
+									"metric_type": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.ServiceLevelIndicatorMetricType](),
+										Optional:   true,
+									},
+									"operation_name": schema.StringAttribute{
+										Optional: true,
+									},
+									"period_seconds": schema.Int32Attribute{
+										Optional: true,
+										Validators: []validator.Int32{
+											int32validator.Between(60, 900),
+										},
+									},
+									"statistic": schema.StringAttribute{
+										Optional: true,
+									},
+								},
+								Blocks: map[string]schema.Block{
+									"metric_data_query": metricDataQueryBlock(),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *serviceLevelObjectiveResource) ConfigValidators(context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("request_based_sli"),
+			path.MatchRoot("sli"),
+		),
+	}
+}
+
+func (r *serviceLevelObjectiveResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data serviceLevelObjectiveResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ApplicationSignalsClient(ctx)
+
+	name := data.Name.ValueString()
+	var input applicationsignals.CreateServiceLevelObjectiveInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input, fwflex.WithFieldNameSuffix("Config"))...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateServiceLevelObjective(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Application Signals Service Level Objective (%s)", name), err.Error())
+
+		return
+	}
+
+	arn := aws.ToString(output.Slo.Arn)
+
+	if !data.ExclusionWindow.IsNull() {
+		var add []awstypes.ExclusionWindow
+		response.Diagnostics.Append(fwflex.Expand(ctx, data.ExclusionWindow, &add)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		if err := updateExclusionWindows(ctx, conn, arn, add, nil); err != nil {
+			response.State.SetAttribute(ctx, path.Root(names.AttrARN), arn) // Set 'arn' so as to taint the resource.
+			response.Diagnostics.AddError(fmt.Sprintf("adding Application Signals Service Level Objective (%s) exclusion windows", arn), err.Error())
+
+			return
+		}
+	}
+
+	// Set values for unknowns.
+	response.Diagnostics.Append(data.flatten(ctx, output.Slo)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *serviceLevelObjectiveResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data serviceLevelObjectiveResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ApplicationSignalsClient(ctx)
+
+	arn := data.ARN.ValueString()
+	output, err := findServiceLevelObjectiveByID(ctx, conn, arn)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Application Signals Service Level Objective (%s)", arn), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(data.flatten(ctx, output)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	exclusionWindows, err := findExclusionWindowsByID(ctx, conn, arn)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Application Signals Service Level Objective (%s) exclusion windows", arn), err.Error())
+
+		return
+	}
+
+	if len(exclusionWindows) > 0 || !data.ExclusionWindow.IsNull() {
+		response.Diagnostics.Append(fwflex.Flatten(ctx, exclusionWindows, &data.ExclusionWindow)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *serviceLevelObjectiveResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new, old serviceLevelObjectiveResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ApplicationSignalsClient(ctx)
+
+	arn := new.ARN.ValueString()
+
+	if !new.BurnRateConfiguration.Equal(old.BurnRateConfiguration) ||
+		!new.Description.Equal(old.Description) ||
+		!new.Goal.Equal(old.Goal) ||
+		!new.RequestBasedSLI.Equal(old.RequestBasedSLI) ||
+		!new.SLI.Equal(old.SLI) {
+		var input applicationsignals.UpdateServiceLevelObjectiveInput
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, &input, fwflex.WithFieldNameSuffix("Config"))...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Additional fields.
+		input.Id = aws.String(arn)
+
+		output, err := conn.UpdateServiceLevelObjective(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Application Signals Service Level Objective (%s)", arn), err.Error())
+
+			return
+		}
+
+		// Set values for unknowns.
+		response.Diagnostics.Append(new.flatten(ctx, output.Slo)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if !new.ExclusionWindow.Equal(old.ExclusionWindow) {
+		var add, remove []awstypes.ExclusionWindow
+		response.Diagnostics.Append(fwflex.Expand(ctx, new.ExclusionWindow, &add)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+		response.Diagnostics.Append(fwflex.Expand(ctx, old.ExclusionWindow, &remove)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Exclusion windows have no identity, so replace the whole set.
+		if len(remove) > 0 {
+			if err := updateExclusionWindows(ctx, conn, arn, nil, remove); err != nil {
+				response.Diagnostics.AddError(fmt.Sprintf("removing Application Signals Service Level Objective (%s) exclusion windows", arn), err.Error())
+
+				return
+			}
+		}
+
+		if len(add) > 0 {
+			if err := updateExclusionWindows(ctx, conn, arn, add, nil); err != nil {
+				response.Diagnostics.AddError(fmt.Sprintf("adding Application Signals Service Level Objective (%s) exclusion windows", arn), err.Error())
+
+				return
+			}
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *serviceLevelObjectiveResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data serviceLevelObjectiveResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ApplicationSignalsClient(ctx)
+
+	arn := data.ARN.ValueString()
+	input := applicationsignals.DeleteServiceLevelObjectiveInput{
+		Id: aws.String(arn),
+	}
+	_, err := conn.DeleteServiceLevelObjective(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Application Signals Service Level Objective (%s)", arn), err.Error())
+
+		return
+	}
+}
+
+func (r *serviceLevelObjectiveResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrARN), request, response)
+}
+
+func updateExclusionWindows(ctx context.Context, conn *applicationsignals.Client, id string, add, remove []awstypes.ExclusionWindow) error {
+	input := applicationsignals.BatchUpdateExclusionWindowsInput{
+		AddExclusionWindows:    add,
+		RemoveExclusionWindows: remove,
+		SloIds:                 []string{id},
+	}
+	output, err := conn.BatchUpdateExclusionWindows(ctx, &input)
+
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, v := range output.Errors {
+		errs = append(errs, fmt.Errorf("%s: %s", aws.ToString(v.ErrorCode), aws.ToString(v.ErrorMessage)))
+	}
+
+	return errors.Join(errs...)
+}
+
+func findServiceLevelObjectiveByID(ctx context.Context, conn *applicationsignals.Client, id string) (*awstypes.ServiceLevelObjective, error) {
+	input := applicationsignals.GetServiceLevelObjectiveInput{
+		Id: aws.String(id),
+	}
+	output, err := conn.GetServiceLevelObjective(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Slo == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Slo, nil
+}
+
+func findExclusionWindowsByID(ctx context.Context, conn *applicationsignals.Client, id string) ([]awstypes.ExclusionWindow, error) {
+	input := applicationsignals.ListServiceLevelObjectiveExclusionWindowsInput{
+		Id: aws.String(id),
+	}
+	var output []awstypes.ExclusionWindow
+
+	pages := applicationsignals.NewListServiceLevelObjectiveExclusionWindowsPaginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.ExclusionWindows...)
+	}
+
+	return output, nil
+}
+
+type serviceLevelObjectiveResourceModel struct {
+	ARN                   types.String                                                `tfsdk:"arn"`
+	BurnRateConfiguration fwtypes.ListNestedObjectValueOf[burnRateConfigurationModel] `tfsdk:"burn_rate_configuration"`
+	Description           types.String                                                `tfsdk:"description"`
+	EvaluationType        fwtypes.StringEnum[awstypes.EvaluationType]                 `tfsdk:"evaluation_type"`
+	ExclusionWindow       fwtypes.ListNestedObjectValueOf[exclusionWindowModel]       `tfsdk:"exclusion_window" autoflex:"-"`
+	Goal                  fwtypes.ListNestedObjectValueOf[goalModel]                  `tfsdk:"goal"`
+	Name                  types.String                                                `tfsdk:"name"`
+	RequestBasedSLI       fwtypes.ListNestedObjectValueOf[requestBasedSLIModel]       `tfsdk:"request_based_sli"`
+	SLI                   fwtypes.ListNestedObjectValueOf[sliModel]                   `tfsdk:"sli"`
+	Tags                  tftags.Map                                                  `tfsdk:"tags"`
+	TagsAll               tftags.Map                                                  `tfsdk:"tags_all"`
+}
+
+// flatten sets the model's values from the API representation, keeping those
+// configured values that the API either does not return or expands on.
+func (data *serviceLevelObjectiveResourceModel) flatten(ctx context.Context, slo *awstypes.ServiceLevelObjective) (diags diag.Diagnostics) {
+	old := *data
+
+	diags.Append(fwflex.Flatten(ctx, slo, data)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	// A default goal is applied when none is configured.
+	if old.Goal.IsNull() {
+		data.Goal = old.Goal
+	}
+
+	oldSLI, d := old.SLI.ToPtr(ctx)
+	diags.Append(d...)
+	newSLI, d := data.SLI.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if oldSLI != nil && newSLI != nil {
+		oldMetric, d := oldSLI.SLIMetric.ToPtr(ctx)
+		diags.Append(d...)
+		newMetric, d := newSLI.SLIMetric.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+
+		if oldMetric != nil && newMetric != nil {
+			// Period and statistic are folded into the generated metric data queries.
+			newMetric.PeriodSeconds = oldMetric.PeriodSeconds
+			newMetric.Statistic = oldMetric.Statistic
+			if isNullOrEmpty(oldMetric.MetricDataQuery) {
+				newMetric.MetricDataQuery = oldMetric.MetricDataQuery
+			}
+			newSLI.SLIMetric = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, newMetric)
+			data.SLI = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, newSLI)
+		}
+	}
+
+	oldRequestBasedSLI, d := old.RequestBasedSLI.ToPtr(ctx)
+	diags.Append(d...)
+	newRequestBasedSLI, d := data.RequestBasedSLI.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if oldRequestBasedSLI != nil && newRequestBasedSLI != nil {
+		oldMetric, d := oldRequestBasedSLI.RequestBasedSLIMetric.ToPtr(ctx)
+		diags.Append(d...)
+		newMetric, d := newRequestBasedSLI.RequestBasedSLIMetric.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+
+		if oldMetric != nil && newMetric != nil {
+			if isNullOrEmpty(oldMetric.MonitoredRequestCountMetric) {
+				newMetric.MonitoredRequestCountMetric = oldMetric.MonitoredRequestCountMetric
+			}
+			if isNullOrEmpty(oldMetric.TotalRequestCountMetric) {
+				newMetric.TotalRequestCountMetric = oldMetric.TotalRequestCountMetric
+			}
+			newRequestBasedSLI.RequestBasedSLIMetric = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, newMetric)
+			data.RequestBasedSLI = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, newRequestBasedSLI)
+		}
+	}
+
+	return diags
+}
+
+func isNullOrEmpty[T any](v fwtypes.ListNestedObjectValueOf[T]) bool {
+	return v.IsNull() || len(v.Elements()) == 0
+}
+
+type burnRateConfigurationModel struct {
+	LookBackWindowMinutes types.Int32 `tfsdk:"look_back_window_minutes"`
+}
+
+type exclusionWindowModel struct {
+	Reason         types.String                                         `tfsdk:"reason"`
+	RecurrenceRule fwtypes.ListNestedObjectValueOf[recurrenceRuleModel] `tfsdk:"recurrence_rule"`
+	StartTime      timetypes.RFC3339                                    `tfsdk:"start_time"`
+	Window         fwtypes.ListNestedObjectValueOf[windowModel]         `tfsdk:"window"`
+}
+
+type recurrenceRuleModel struct {
+	Expression types.String `tfsdk:"expression"`
+}
+
+type windowModel struct {
+	Duration     types.Int32                               `tfsdk:"duration"`
+	DurationUnit fwtypes.StringEnum[awstypes.DurationUnit] `tfsdk:"duration_unit"`
+}
+
+type goalModel struct {
+	AttainmentGoal   types.Float64                                  `tfsdk:"attainment_goal"`
+	Interval         fwtypes.ListNestedObjectValueOf[intervalModel] `tfsdk:"interval"`
+	WarningThreshold types.Float64                                  `tfsdk:"warning_threshold"`
+}
+
+type intervalModel struct {
+	CalendarInterval fwtypes.ListNestedObjectValueOf[calendarIntervalModel] `tfsdk:"calendar_interval"`
+	RollingInterval  fwtypes.ListNestedObjectValueOf[rollingIntervalModel]  `tfsdk:"rolling_interval"`
+}
+
+var (
+	_ fwflex.Expander  = intervalModel{}
+	_ fwflex.Flattener = &intervalModel{}
+)
+
+func (m intervalModel) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
+	switch {
+	case !m.CalendarInterval.IsNull():
+		calendarIntervalData, d := m.CalendarInterval.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.IntervalMemberCalendarInterval
+		diags.Append(fwflex.Expand(ctx, calendarIntervalData, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+
+	case !m.RollingInterval.IsNull():
+		rollingIntervalData, d := m.RollingInterval.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.IntervalMemberRollingInterval
+		diags.Append(fwflex.Expand(ctx, rollingIntervalData, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+	}
+
+	return nil, diags
+}
+
+func (m *intervalModel) Flatten(ctx context.Context, v any) (diags diag.Diagnostics) {
+	switch t := v.(type) {
+	case awstypes.IntervalMemberCalendarInterval:
+		var model calendarIntervalModel
+		d := fwflex.Flatten(ctx, t.Value, &model)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.CalendarInterval = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+
+		return diags
+
+	case awstypes.IntervalMemberRollingInterval:
+		var model rollingIntervalModel
+		d := fwflex.Flatten(ctx, t.Value, &model)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.RollingInterval = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+
+		return diags
+	}
+
+	return diags
+}
+
+type calendarIntervalModel struct {
+	Duration     types.Int32                               `tfsdk:"duration"`
+	DurationUnit fwtypes.StringEnum[awstypes.DurationUnit] `tfsdk:"duration_unit"`
+	StartTime    timetypes.RFC3339                         `tfsdk:"start_time"`
+}
+
+type rollingIntervalModel struct {
+	Duration     types.Int32                               `tfsdk:"duration"`
+	DurationUnit fwtypes.StringEnum[awstypes.DurationUnit] `tfsdk:"duration_unit"`
+}
+
+type sliModel struct {
+	ComparisonOperator fwtypes.StringEnum[awstypes.ServiceLevelIndicatorComparisonOperator] `tfsdk:"comparison_operator"`
+	MetricThreshold    types.Float64                                                        `tfsdk:"metric_threshold"`
+	SLIMetric          fwtypes.ListNestedObjectValueOf[sliMetricModel]                      `tfsdk:"sli_metric"`
+}
+
+type sliMetricModel struct {
+	KeyAttributes   fwtypes.MapOfString                                          `tfsdk:"key_attributes"`
+	MetricDataQuery fwtypes.ListNestedObjectValueOf[metricDataQueryModel]        `tfsdk:"metric_data_query"`
+	MetricType      fwtypes.StringEnum[awstypes.ServiceLevelIndicatorMetricType] `tfsdk:"metric_type"`
+	OperationName   types.String                                                 `tfsdk:"operation_name"`
+	PeriodSeconds   types.Int32                                                  `tfsdk:"period_seconds"`
+	Statistic       types.String                                                 `tfsdk:"statistic"`
+}
+
+type requestBasedSLIModel struct {
+	ComparisonOperator    fwtypes.StringEnum[awstypes.ServiceLevelIndicatorComparisonOperator] `tfsdk:"comparison_operator"`
+	MetricThreshold       types.Float64                                                        `tfsdk:"metric_threshold"`
+	RequestBasedSLIMetric fwtypes.ListNestedObjectValueOf[requestBasedSLIMetricModel]          `tfsdk:"request_based_sli_metric"`
+}
+
+type requestBasedSLIMetricModel struct {
+	KeyAttributes               fwtypes.MapOfString                                               `tfsdk:"key_attributes"`
+	MetricType                  fwtypes.StringEnum[awstypes.ServiceLevelIndicatorMetricType]      `tfsdk:"metric_type"`
+	MonitoredRequestCountMetric fwtypes.ListNestedObjectValueOf[monitoredRequestCountMetricModel] `tfsdk:"monitored_request_count_metric"`
+	OperationName               types.String                                                      `tfsdk:"operation_name"`
+	TotalRequestCountMetric     fwtypes.ListNestedObjectValueOf[metricDataQueryModel]             `tfsdk:"total_request_count_metric"`
+}
+
+type monitoredRequestCountMetricModel struct {
+	BadCountMetric  fwtypes.ListNestedObjectValueOf[metricDataQueryModel] `tfsdk:"bad_count_metric"`
+	GoodCountMetric fwtypes.ListNestedObjectValueOf[metricDataQueryModel] `tfsdk:"good_count_metric"`
+}
+
+var (
+	_ fwflex.Expander  = monitoredRequestCountMetricModel{}
+	_ fwflex.Flattener = &monitoredRequestCountMetricModel{}
+)
+
+func (m monitoredRequestCountMetricModel) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
+	switch {
+	case !m.BadCountMetric.IsNull():
+		var r awstypes.MonitoredRequestCountMetricDataQueriesMemberBadCountMetric
+		diags.Append(fwflex.Expand(ctx, m.BadCountMetric, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+
+	case !m.GoodCountMetric.IsNull():
+		var r awstypes.MonitoredRequestCountMetricDataQueriesMemberGoodCountMetric
+		diags.Append(fwflex.Expand(ctx, m.GoodCountMetric, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+	}
+
+	return nil, diags
+}
+
+func (m *monitoredRequestCountMetricModel) Flatten(ctx context.Context, v any) (diags diag.Diagnostics) {
+	switch t := v.(type) {
+	case awstypes.MonitoredRequestCountMetricDataQueriesMemberBadCountMetric:
+		diags.Append(fwflex.Flatten(ctx, t.Value, &m.BadCountMetric)...)
+
+		return diags
+
+	case awstypes.MonitoredRequestCountMetricDataQueriesMemberGoodCountMetric:
+		diags.Append(fwflex.Flatten(ctx, t.Value, &m.GoodCountMetric)...)
+
+		return diags
+	}
+
+	return diags
+}
+
+type metricDataQueryModel struct {
+	AccountID  types.String                                     `tfsdk:"account_id"`
+	Expression types.String                                     `tfsdk:"expression"`
+	ID         types.String                                     `tfsdk:"id"`
+	Label      types.String                                     `tfsdk:"label"`
+	MetricStat fwtypes.ListNestedObjectValueOf[metricStatModel] `tfsdk:"metric_stat"`
+	Period     types.Int32                                      `tfsdk:"period"`
+	ReturnData types.Bool                                       `tfsdk:"return_data"`
+}
+
+type metricStatModel struct {
+	Metric fwtypes.ListNestedObjectValueOf[metricModel] `tfsdk:"metric"`
+	Period types.Int32                                  `tfsdk:"period"`
+	Stat   types.String                                 `tfsdk:"stat"`
+	Unit   fwtypes.StringEnum[awstypes.StandardUnit]    `tfsdk:"unit"`
+}
+
+type metricModel struct {
+	Dimension  fwtypes.ListNestedObjectValueOf[dimensionModel] `tfsdk:"dimension"`
+	MetricName types.String                                    `tfsdk:"metric_name"`
+	Namespace  types.String                                    `tfsdk:"namespace"`
+}
+
+type dimensionModel struct {
+	Name  types.String `tfsdk:"name"`
+	Value types.String `tfsdk:"value"`
+}
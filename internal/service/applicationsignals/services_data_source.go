@@ -0,0 +1,130 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package applicationsignals
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/applicationsignals"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/applicationsignals/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// Services discovered in the last 3 hours are returned by default.
+	defaultServicesLookBack = 3 * time.Hour
+)
+
+// @FrameworkDataSource("aws_applicationsignals_services", name="Services")
+func newServicesDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &servicesDataSource{}, nil
+}
+
+type servicesDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *servicesDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"end_time": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Optional:   true,
+				Computed:   true,
+			},
+			"services": framework.DataSourceComputedListOfObjectAttribute[serviceSummaryModel](ctx),
+			names.AttrStartTime: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Optional:   true,
+				Computed:   true,
+			},
+		},
+	}
+}
+
+func (d *servicesDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data servicesDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().ApplicationSignalsClient(ctx)
+
+	endTime := time.Now()
+	if !data.EndTime.IsNull() {
+		v, diags := data.EndTime.ValueRFC3339Time()
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+		endTime = v
+	}
+	startTime := endTime.Add(-defaultServicesLookBack)
+	if !data.StartTime.IsNull() {
+		v, diags := data.StartTime.ValueRFC3339Time()
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+		startTime = v
+	}
+
+	input := applicationsignals.ListServicesInput{
+		EndTime:   aws.Time(endTime),
+		StartTime: aws.Time(startTime),
+	}
+	output, err := findServices(ctx, conn, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError("reading Application Signals Services", err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data.Services)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.EndTime = timetypes.NewRFC3339TimeValue(endTime)
+	data.StartTime = timetypes.NewRFC3339TimeValue(startTime)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func findServices(ctx context.Context, conn *applicationsignals.Client, input *applicationsignals.ListServicesInput) ([]awstypes.ServiceSummary, error) {
+	var output []awstypes.ServiceSummary
+
+	pages := applicationsignals.NewListServicesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.ServiceSummaries...)
+	}
+
+	return output, nil
+}
+
+type servicesDataSourceModel struct {
+	EndTime   timetypes.RFC3339                                    `tfsdk:"end_time"`
+	Services  fwtypes.ListNestedObjectValueOf[serviceSummaryModel] `tfsdk:"services"`
+	StartTime timetypes.RFC3339                                    `tfsdk:"start_time"`
+}
+
+type serviceSummaryModel struct {
+	KeyAttributes fwtypes.MapOfString `tfsdk:"key_attributes"`
+}
@@ -0,0 +1,797 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package bedrock
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/bedrock"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/bedrock/types"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_bedrock_evaluation_job", name="Evaluation Job")
+// @Tags(identifierAttribute="arn")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/bedrock;bedrock.GetEvaluationJobOutput")
+func newEvaluationJobResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &evaluationJobResource{}
+
+	r.SetDefaultDeleteTimeout(60 * time.Minute)
+
+	return r, nil
+}
+
+type evaluationJobResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (r *evaluationJobResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"application_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ApplicationType](),
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrCreationTime: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"customer_encryption_key_id": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"job_description": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 200),
+				},
+			},
+			"job_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 63),
+					stringvalidator.RegexMatches(regexache.MustCompile(`^[a-z0-9](-*[a-z0-9]){0,62}$`), "must contain only lowercase alphanumeric characters and hyphens"),
+				},
+			},
+			"job_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.EvaluationJobType](),
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_modified_time": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			names.AttrRoleARN: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.EvaluationJobStatus](),
+				Computed:   true,
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"evaluation_config": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[evaluationConfigModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"automated": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[automatedEvaluationConfigModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtLeast(1),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Blocks: map[string]schema.Block{
+									"dataset_metric_config": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[evaluationDatasetMetricConfigModel](ctx),
+										Validators: []validator.List{
+											listvalidator.IsRequired(),
+											listvalidator.SizeBetween(1, 5),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"metric_names": schema.SetAttribute{
+													CustomType:  fwtypes.SetOfStringType,
+													Required:    true,
+													ElementType: types.StringType,
+													Validators: []validator.Set{
+														setvalidator.SizeBetween(1, 10),
+													},
+												},
+												"task_type": schema.StringAttribute{
+													CustomType: fwtypes.StringEnumType[awstypes.EvaluationTaskType](),
+													Required:   true,
+												},
+											},
+											Blocks: map[string]schema.Block{
+												"dataset": schema.ListNestedBlock{
+													CustomType: fwtypes.NewListNestedObjectTypeOf[evaluationDatasetModel](ctx),
+													Validators: []validator.List{
+														listvalidator.IsRequired(),
+														listvalidator.SizeAtLeast(1),
+														listvalidator.SizeAtMost(1),
+													},
+													NestedObject: schema.NestedBlockObject{
+														Attributes: map[string]schema.Attribute{
+															names.AttrName: schema.StringAttribute{
+																Required: true,
+																Validators: []validator.String{
+																	stringvalidator.LengthBetween(1, 63),
+																},
+															},
+														},
+														Blocks: map[string]schema.Block{
+															"dataset_location": schema.ListNestedBlock{
+																CustomType: fwtypes.NewListNestedObjectTypeOf[evaluationDatasetLocationModel](ctx),
+																Validators: []validator.List{
+																	listvalidator.SizeAtMost(1),
+																},
+																NestedObject: schema.NestedBlockObject{
+																	Attributes: map[string]schema.Attribute{
+																		"s3_uri": schema.StringAttribute{
+																			Required: true,
+																			Validators: []validator.String{
+																				fwvalidators.S3URI(),
+																			},
+																		},
+																	},
+																},
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"inference_config": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[evaluationInferenceConfigModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"model": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[evaluationModelConfigModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeBetween(1, 2),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Blocks: map[string]schema.Block{
+									"bedrock_model": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[evaluationBedrockModelModel](ctx),
+										Validators: []validator.List{
+											listvalidator.IsRequired(),
+											listvalidator.SizeAtLeast(1),
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"inference_params": schema.StringAttribute{
+													CustomType: jsontypes.NormalizedType{},
+													Required:   true,
+												},
+												"model_identifier": schema.StringAttribute{
+													Required: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"output_data_config": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[outputDataConfigModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"s3_uri": schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								fwvalidators.S3URI(),
+							},
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *evaluationJobResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data evaluationJobResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BedrockClient(ctx)
+
+	name := data.JobName.ValueString()
+	var input bedrock.CreateEvaluationJobInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ClientRequestToken = aws.String(id.UniqueId())
+	input.JobTags = getTagsIn(ctx)
+
+	outputRaw, err := tfresource.RetryWhenAWSErrMessageContains(ctx, propagationTimeout, func() (any, error) {
+		return conn.CreateEvaluationJob(ctx, &input)
+	}, errCodeValidationException, "Could not assume provided IAM role")
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Bedrock Evaluation Job (%s)", name), err.Error())
+
+		return
+	}
+
+	jobARN := aws.ToString(outputRaw.(*bedrock.CreateEvaluationJobOutput).JobArn)
+	job, err := findEvaluationJobByID(ctx, conn, jobARN)
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), jobARN) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("reading Bedrock Evaluation Job (%s)", jobARN), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ApplicationType = fwtypes.StringEnumValue(job.ApplicationType)
+	data.CreationTime = timetypes.NewRFC3339TimePointerValue(job.CreationTime)
+	data.JobARN = fwflex.StringToFramework(ctx, job.JobArn)
+	data.JobType = fwtypes.StringEnumValue(job.JobType)
+	data.LastModifiedTime = timetypes.NewRFC3339TimePointerValue(job.LastModifiedTime)
+	data.Status = fwtypes.StringEnumValue(job.Status)
+	data.setID()
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *evaluationJobResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data evaluationJobResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().BedrockClient(ctx)
+
+	output, err := findEvaluationJobByID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Bedrock Evaluation Job (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *evaluationJobResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new evaluationJobResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Update is only called when `tags` are updated.
+	// Set unknowns to the old (in state) values.
+	new.LastModifiedTime = old.LastModifiedTime
+	new.Status = old.Status
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *evaluationJobResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data evaluationJobResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BedrockClient(ctx)
+
+	jobARN := data.ID.ValueString()
+	timeout := r.DeleteTimeout(ctx, data.Timeouts)
+
+	// In-progress evaluation jobs must be stopped before they can be deleted.
+	if data.Status.ValueEnum() == awstypes.EvaluationJobStatusInProgress {
+		input := bedrock.StopEvaluationJobInput{
+			JobIdentifier: aws.String(jobARN),
+		}
+		_, err := conn.StopEvaluationJob(ctx, &input)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return
+		}
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("stopping Bedrock Evaluation Job (%s)", jobARN), err.Error())
+
+			return
+		}
+
+		if _, err := waitEvaluationJobStopped(ctx, conn, jobARN, timeout); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for Bedrock Evaluation Job (%s) stop", jobARN), err.Error())
+
+			return
+		}
+	}
+
+	input := bedrock.BatchDeleteEvaluationJobInput{
+		JobIdentifiers: []string{jobARN},
+	}
+	output, err := conn.BatchDeleteEvaluationJob(ctx, &input)
+
+	if err == nil && output != nil && len(output.Errors) > 0 {
+		err = batchDeleteEvaluationJobError(output.Errors)
+	}
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Bedrock Evaluation Job (%s)", jobARN), err.Error())
+
+		return
+	}
+
+	if _, err := waitEvaluationJobDeleted(ctx, conn, jobARN, timeout); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Bedrock Evaluation Job (%s) delete", jobARN), err.Error())
+
+		return
+	}
+}
+
+func batchDeleteEvaluationJobError(apiObjects []awstypes.BatchDeleteEvaluationJobError) error {
+	var errs []error
+
+	for _, apiObject := range apiObjects {
+		// Already deleted.
+		if strings.Contains(aws.ToString(apiObject.Code), "ResourceNotFound") {
+			continue
+		}
+
+		errs = append(errs, fmt.Errorf("%s: %s: %s", aws.ToString(apiObject.JobIdentifier), aws.ToString(apiObject.Code), aws.ToString(apiObject.Message)))
+	}
+
+	return errors.Join(errs...)
+}
+
+func findEvaluationJobByID(ctx context.Context, conn *bedrock.Client, id string) (*bedrock.GetEvaluationJobOutput, error) {
+	input := bedrock.GetEvaluationJobInput{
+		JobIdentifier: aws.String(id),
+	}
+
+	output, err := conn.GetEvaluationJob(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusEvaluationJob(ctx context.Context, conn *bedrock.Client, id string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, err := findEvaluationJobByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitEvaluationJobCompleted(ctx context.Context, conn *bedrock.Client, id string, timeout time.Duration) (*bedrock.GetEvaluationJobOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.EvaluationJobStatusInProgress),
+		Target:  enum.Slice(awstypes.EvaluationJobStatusCompleted),
+		Refresh: statusEvaluationJob(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*bedrock.GetEvaluationJobOutput); ok {
+		tfresource.SetLastError(err, errors.New(strings.Join(output.FailureMessages, "; ")))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitEvaluationJobStopped(ctx context.Context, conn *bedrock.Client, id string, timeout time.Duration) (*bedrock.GetEvaluationJobOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.EvaluationJobStatusInProgress, awstypes.EvaluationJobStatusStopping),
+		Target:  enum.Slice(awstypes.EvaluationJobStatusStopped, awstypes.EvaluationJobStatusCompleted, awstypes.EvaluationJobStatusFailed),
+		Refresh: statusEvaluationJob(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*bedrock.GetEvaluationJobOutput); ok {
+		tfresource.SetLastError(err, errors.New(strings.Join(output.FailureMessages, "; ")))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitEvaluationJobDeleted(ctx context.Context, conn *bedrock.Client, id string, timeout time.Duration) (*bedrock.GetEvaluationJobOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(
+			awstypes.EvaluationJobStatusCompleted,
+			awstypes.EvaluationJobStatusDeleting,
+			awstypes.EvaluationJobStatusFailed,
+			awstypes.EvaluationJobStatusStopped,
+		),
+		Target:  []string{},
+		Refresh: statusEvaluationJob(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*bedrock.GetEvaluationJobOutput); ok {
+		tfresource.SetLastError(err, errors.New(strings.Join(output.FailureMessages, "; ")))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+type evaluationJobResourceModel struct {
+	ApplicationType         fwtypes.StringEnum[awstypes.ApplicationType]                    `tfsdk:"application_type"`
+	CreationTime            timetypes.RFC3339                                               `tfsdk:"creation_time"`
+	CustomerEncryptionKeyID types.String                                                    `tfsdk:"customer_encryption_key_id"`
+	EvaluationConfig        fwtypes.ListNestedObjectValueOf[evaluationConfigModel]          `tfsdk:"evaluation_config"`
+	ID                      types.String                                                    `tfsdk:"id"`
+	InferenceConfig         fwtypes.ListNestedObjectValueOf[evaluationInferenceConfigModel] `tfsdk:"inference_config"`
+	JobARN                  types.String                                                    `tfsdk:"arn"`
+	JobDescription          types.String                                                    `tfsdk:"job_description"`
+	JobName                 types.String                                                    `tfsdk:"job_name"`
+	JobType                 fwtypes.StringEnum[awstypes.EvaluationJobType]                  `tfsdk:"job_type"`
+	LastModifiedTime        timetypes.RFC3339                                               `tfsdk:"last_modified_time"`
+	OutputDataConfig        fwtypes.ListNestedObjectValueOf[outputDataConfigModel]          `tfsdk:"output_data_config"`
+	RoleARN                 fwtypes.ARN                                                     `tfsdk:"role_arn"`
+	Status                  fwtypes.StringEnum[awstypes.EvaluationJobStatus]                `tfsdk:"status"`
+	Tags                    tftags.Map                                                      `tfsdk:"tags"`
+	TagsAll                 tftags.Map                                                      `tfsdk:"tags_all"`
+	Timeouts                timeouts.Value                                                  `tfsdk:"timeouts"`
+}
+
+func (data *evaluationJobResourceModel) InitFromID() error {
+	data.JobARN = data.ID
+
+	return nil
+}
+
+func (data *evaluationJobResourceModel) setID() {
+	data.ID = data.JobARN
+}
+
+// Tagged union.
+type evaluationConfigModel struct {
+	Automated fwtypes.ListNestedObjectValueOf[automatedEvaluationConfigModel] `tfsdk:"automated"`
+}
+
+var (
+	_ fwflex.Expander  = evaluationConfigModel{}
+	_ fwflex.Flattener = &evaluationConfigModel{}
+)
+
+func (m evaluationConfigModel) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
+	switch {
+	case !m.Automated.IsNull():
+		automatedEvaluationConfigData, d := m.Automated.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.EvaluationConfigMemberAutomated
+		diags.Append(fwflex.Expand(ctx, automatedEvaluationConfigData, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+	}
+
+	return nil, diags
+}
+
+func (m *evaluationConfigModel) Flatten(ctx context.Context, v any) (diags diag.Diagnostics) {
+	switch t := v.(type) {
+	case awstypes.EvaluationConfigMemberAutomated:
+		var model automatedEvaluationConfigModel
+		diags.Append(fwflex.Flatten(ctx, t.Value, &model)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.Automated = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+
+		return diags
+	}
+
+	return diags
+}
+
+type automatedEvaluationConfigModel struct {
+	DatasetMetricConfigs fwtypes.ListNestedObjectValueOf[evaluationDatasetMetricConfigModel] `tfsdk:"dataset_metric_config"`
+}
+
+type evaluationDatasetMetricConfigModel struct {
+	Dataset     fwtypes.ListNestedObjectValueOf[evaluationDatasetModel] `tfsdk:"dataset"`
+	MetricNames fwtypes.SetOfString                                     `tfsdk:"metric_names"`
+	TaskType    fwtypes.StringEnum[awstypes.EvaluationTaskType]         `tfsdk:"task_type"`
+}
+
+type evaluationDatasetModel struct {
+	DatasetLocation fwtypes.ListNestedObjectValueOf[evaluationDatasetLocationModel] `tfsdk:"dataset_location"`
+	Name            types.String                                                    `tfsdk:"name"`
+}
+
+// Tagged union.
+type evaluationDatasetLocationModel struct {
+	S3URI types.String `tfsdk:"s3_uri"`
+}
+
+var (
+	_ fwflex.Expander  = evaluationDatasetLocationModel{}
+	_ fwflex.Flattener = &evaluationDatasetLocationModel{}
+)
+
+func (m evaluationDatasetLocationModel) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
+	switch {
+	case !m.S3URI.IsNull():
+		return &awstypes.EvaluationDatasetLocationMemberS3Uri{
+			Value: m.S3URI.ValueString(),
+		}, diags
+	}
+
+	return nil, diags
+}
+
+func (m *evaluationDatasetLocationModel) Flatten(ctx context.Context, v any) (diags diag.Diagnostics) {
+	switch t := v.(type) {
+	case awstypes.EvaluationDatasetLocationMemberS3Uri:
+		m.S3URI = fwflex.StringValueToFramework(ctx, t.Value)
+
+		return diags
+	}
+
+	return diags
+}
+
+// Tagged union.
+type evaluationInferenceConfigModel struct {
+	Models fwtypes.ListNestedObjectValueOf[evaluationModelConfigModel] `tfsdk:"model"`
+}
+
+var (
+	_ fwflex.Expander  = evaluationInferenceConfigModel{}
+	_ fwflex.Flattener = &evaluationInferenceConfigModel{}
+)
+
+func (m evaluationInferenceConfigModel) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
+	switch {
+	case !m.Models.IsNull():
+		var r awstypes.EvaluationInferenceConfigMemberModels
+		diags.Append(fwflex.Expand(ctx, m.Models, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+	}
+
+	return nil, diags
+}
+
+func (m *evaluationInferenceConfigModel) Flatten(ctx context.Context, v any) (diags diag.Diagnostics) {
+	switch t := v.(type) {
+	case awstypes.EvaluationInferenceConfigMemberModels:
+		diags.Append(fwflex.Flatten(ctx, t.Value, &m.Models)...)
+
+		return diags
+	}
+
+	return diags
+}
+
+// Tagged union.
+type evaluationModelConfigModel struct {
+	BedrockModel fwtypes.ListNestedObjectValueOf[evaluationBedrockModelModel] `tfsdk:"bedrock_model"`
+}
+
+var (
+	_ fwflex.Expander  = evaluationModelConfigModel{}
+	_ fwflex.Flattener = &evaluationModelConfigModel{}
+)
+
+func (m evaluationModelConfigModel) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
+	switch {
+	case !m.BedrockModel.IsNull():
+		evaluationBedrockModelData, d := m.BedrockModel.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.EvaluationModelConfigMemberBedrockModel
+		diags.Append(fwflex.Expand(ctx, evaluationBedrockModelData, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+	}
+
+	return nil, diags
+}
+
+func (m *evaluationModelConfigModel) Flatten(ctx context.Context, v any) (diags diag.Diagnostics) {
+	switch t := v.(type) {
+	case awstypes.EvaluationModelConfigMemberBedrockModel:
+		var model evaluationBedrockModelModel
+		diags.Append(fwflex.Flatten(ctx, t.Value, &model)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.BedrockModel = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+
+		return diags
+	}
+
+	return diags
+}
+
+type evaluationBedrockModelModel struct {
+	InferenceParams jsontypes.Normalized `tfsdk:"inference_params"`
+	ModelIdentifier types.String         `tfsdk:"model_identifier"`
+}
@@ -0,0 +1,242 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package bedrock_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/bedrock"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfbedrock "github.com/hashicorp/terraform-provider-aws/internal/service/bedrock"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccBedrockEvaluationJob_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_bedrock_evaluation_job.test"
+	var v bedrock.GetEvaluationJobOutput
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.BedrockEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.BedrockServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEvaluationJobDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEvaluationJobConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckEvaluationJobExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "application_type", "ModelEvaluation"),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrARN),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrCreationTime),
+					resource.TestCheckResourceAttr(resourceName, "evaluation_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "evaluation_config.0.automated.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "evaluation_config.0.automated.0.dataset_metric_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "evaluation_config.0.automated.0.dataset_metric_config.0.dataset.0.name", "Builtin.BoolQ"),
+					resource.TestCheckResourceAttr(resourceName, "evaluation_config.0.automated.0.dataset_metric_config.0.task_type", "QuestionAndAnswer"),
+					resource.TestCheckResourceAttr(resourceName, "inference_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "inference_config.0.model.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "inference_config.0.model.0.bedrock_model.0.model_identifier", "anthropic.claude-3-haiku-20240307-v1:0"),
+					resource.TestCheckResourceAttr(resourceName, "job_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "job_type", "Automated"),
+					resource.TestCheckResourceAttr(resourceName, "output_data_config.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrRoleARN, "aws_iam_role.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "InProgress"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "0"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"last_modified_time", names.AttrStatus},
+			},
+		},
+	})
+}
+
+func TestAccBedrockEvaluationJob_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_bedrock_evaluation_job.test"
+	var v bedrock.GetEvaluationJobOutput
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.BedrockEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.BedrockServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEvaluationJobDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEvaluationJobConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEvaluationJobExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfbedrock.ResourceEvaluationJob, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckEvaluationJobDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).BedrockClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_bedrock_evaluation_job" {
+				continue
+			}
+
+			_, err := tfbedrock.FindEvaluationJobByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Bedrock Evaluation Job %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckEvaluationJobExists(ctx context.Context, n string, v *bedrock.GetEvaluationJobOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).BedrockClient(ctx)
+
+		output, err := tfbedrock.FindEvaluationJobByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccEvaluationJobConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+data "aws_region" "current" {}
+data "aws_partition" "current" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  # See https://docs.aws.amazon.com/bedrock/latest/userguide/automatic-service-roles.html.
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Principal = {
+        Service = "bedrock.amazonaws.com"
+      }
+      Action = "sts:AssumeRole"
+      Condition = {
+        StringEquals = {
+          "aws:SourceAccount" = data.aws_caller_identity.current.account_id
+        }
+        ArnEquals = {
+          "aws:SourceArn" = "arn:${data.aws_partition.current.partition}:bedrock:${data.aws_region.current.name}:${data.aws_caller_identity.current.account_id}:evaluation-job/*"
+        }
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [
+      {
+        Effect = "Allow"
+        Action = [
+          "s3:GetObject",
+          "s3:PutObject",
+          "s3:ListBucket",
+        ]
+        Resource = [
+          aws_s3_bucket.test.arn,
+          "${aws_s3_bucket.test.arn}/*",
+        ]
+      },
+      {
+        Effect = "Allow"
+        Action = [
+          "bedrock:InvokeModel",
+          "bedrock:InvokeModelWithResponseStream",
+        ]
+        Resource = "arn:${data.aws_partition.current.partition}:bedrock:${data.aws_region.current.name}::foundation-model/*"
+      },
+    ]
+  })
+}
+
+resource "aws_bedrock_evaluation_job" "test" {
+  job_name = %[1]q
+  role_arn = aws_iam_role.test.arn
+
+  evaluation_config {
+    automated {
+      dataset_metric_config {
+        task_type    = "QuestionAndAnswer"
+        metric_names = ["Builtin.Accuracy", "Builtin.Robustness"]
+
+        dataset {
+          name = "Builtin.BoolQ"
+        }
+      }
+    }
+  }
+
+  inference_config {
+    model {
+      bedrock_model {
+        model_identifier = "anthropic.claude-3-haiku-20240307-v1:0"
+        inference_params = jsonencode({
+          inferenceConfig = {
+            maxTokens   = 512
+            temperature = 0
+          }
+        })
+      }
+    }
+  }
+
+  output_data_config {
+    s3_uri = "s3://${aws_s3_bucket.test.bucket}/output/"
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName)
+}
@@ -6,16 +6,25 @@ package bedrock
 // Exports for use in tests only.
 var (
 	ResourceCustomModel                         = newCustomModelResource
+	ResourceEvaluationJob                       = newEvaluationJobResource
 	ResourceGuardrail                           = newResourceGuardrail
 	ResourceGuardrailVersion                    = newGuardrailVersionResource
+	ResourceImportedModel                       = newImportedModelResource
+	ResourceModelInvocationJob                  = newModelInvocationJobResource
 	ResourceModelInvocationLoggingConfiguration = newModelInvocationLoggingConfigurationResource
 	ResourceInferenceProfile                    = newResourceInferenceProfile
+	ResourcePromptRouter                        = newPromptRouterResource
 
 	FindCustomModelByID                     = findCustomModelByID
+	FindEvaluationJobByID                   = findEvaluationJobByID
 	FindGuardrailByTwoPartKey               = findGuardrailByTwoPartKey
+	FindImportedModelByID                   = findImportedModelByID
 	FindModelCustomizationJobByID           = findModelCustomizationJobByID
+	FindModelInvocationJobByID              = findModelInvocationJobByID
 	FindModelInvocationLoggingConfiguration = findModelInvocationLoggingConfiguration
+	FindPromptRouterByARN                   = findPromptRouterByARN
 	FindProvisionedModelThroughputByID      = findProvisionedModelThroughputByID
 
+	WaitEvaluationJobCompleted         = waitEvaluationJobCompleted
 	WaitModelCustomizationJobCompleted = waitModelCustomizationJobCompleted
 )
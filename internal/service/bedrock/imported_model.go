@@ -0,0 +1,508 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package bedrock
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/bedrock"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/bedrock/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_bedrock_imported_model", name="Imported Model")
+// @Tags(identifierAttribute="arn")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/bedrock;bedrock.GetImportedModelOutput")
+func newImportedModelResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &importedModelResource{}
+
+	r.SetDefaultCreateTimeout(120 * time.Minute)
+
+	return r, nil
+}
+
+type importedModelResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithNoOpUpdate[importedModelResourceModel]
+	framework.WithTimeouts
+}
+
+func (r *importedModelResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	// This resource is a composition of the following APIs. These APIs do not have consistently named attributes, so we will normalize them here.
+	// - CreateModelImportJob
+	// - GetModelImportJob
+	// - GetImportedModel
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrCreationTime: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"imported_model_kms_key_id": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Optional:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"imported_model_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 63),
+					stringvalidator.RegexMatches(regexache.MustCompile(`^([0-9a-zA-Z][_-]?){1,63}$`), "must contain only alphanumeric characters, underscores and hyphens"),
+				},
+			},
+			"instruct_supported": schema.BoolAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"job_arn": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"job_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 63),
+					stringvalidator.RegexMatches(regexache.MustCompile(`^[a-zA-Z0-9](-*[a-zA-Z0-9\+\-\.])*$`),
+						"must be up to 63 letters (uppercase and lowercase), numbers, plus sign, dashes, and dots, and must start with an alphanumeric"),
+				},
+			},
+			"model_architecture": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrRoleARN: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"model_data_source": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[modelDataSourceModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"s3_data_source": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[s3DataSourceModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtLeast(1),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"s3_uri": schema.StringAttribute{
+										Required: true,
+										Validators: []validator.String{
+											fwvalidators.S3URI(),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+			}),
+			names.AttrVPCConfig: schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[vpcConfigModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrSecurityGroupIDs: schema.SetAttribute{
+							CustomType:  fwtypes.SetOfStringType,
+							Required:    true,
+							ElementType: types.StringType,
+							PlanModifiers: []planmodifier.Set{
+								setplanmodifier.RequiresReplace(),
+							},
+						},
+						names.AttrSubnetIDs: schema.SetAttribute{
+							CustomType:  fwtypes.SetOfStringType,
+							Required:    true,
+							ElementType: types.StringType,
+							PlanModifiers: []planmodifier.Set{
+								setplanmodifier.RequiresReplace(),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *importedModelResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data importedModelResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BedrockClient(ctx)
+
+	name := data.ImportedModelName.ValueString()
+	var input bedrock.CreateModelImportJobInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ClientRequestToken = aws.String(id.UniqueId())
+	input.ImportedModelTags = getTagsIn(ctx)
+	input.JobTags = getTagsIn(ctx)
+
+	outputRaw, err := tfresource.RetryWhenAWSErrMessageContains(ctx, propagationTimeout, func() (any, error) {
+		return conn.CreateModelImportJob(ctx, &input)
+	}, errCodeValidationException, "Could not assume provided IAM role")
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Bedrock Imported Model (%s) import job", name), err.Error())
+
+		return
+	}
+
+	jobARN := aws.ToString(outputRaw.(*bedrock.CreateModelImportJobOutput).JobArn)
+	job, err := waitModelImportJobCompleted(ctx, conn, jobARN, r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Bedrock Imported Model (%s) import job (%s) complete", name, jobARN), err.Error())
+
+		return
+	}
+
+	modelARN := aws.ToString(job.ImportedModelArn)
+	output, err := findImportedModelByID(ctx, conn, modelARN)
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), modelARN) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("reading Bedrock Imported Model (%s)", modelARN), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	response.Diagnostics.Append(data.flattenImportedModel(ctx, output)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *importedModelResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data importedModelResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BedrockClient(ctx)
+
+	output, err := findImportedModelByID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Bedrock Imported Model (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(data.flattenImportedModel(ctx, output)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// The IAM role and VPC configuration are only available from the import job.
+	if jobARN := aws.ToString(output.JobArn); jobARN != "" {
+		job, err := findModelImportJobByID(ctx, conn, jobARN)
+
+		switch {
+		case tfresource.NotFound(err):
+		case err != nil:
+			response.Diagnostics.AddError(fmt.Sprintf("reading Bedrock Imported Model (%s) import job (%s)", data.ID.ValueString(), jobARN), err.Error())
+
+			return
+		default:
+			data.RoleARN = fwflex.StringToFrameworkARN(ctx, job.RoleArn)
+			response.Diagnostics.Append(fwflex.Flatten(ctx, job.VpcConfig, &data.VPCConfig)...)
+			if response.Diagnostics.HasError() {
+				return
+			}
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *importedModelResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data importedModelResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BedrockClient(ctx)
+
+	input := bedrock.DeleteImportedModelInput{
+		ModelIdentifier: fwflex.StringFromFramework(ctx, data.ID),
+	}
+	_, err := conn.DeleteImportedModel(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Bedrock Imported Model (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func findImportedModelByID(ctx context.Context, conn *bedrock.Client, id string) (*bedrock.GetImportedModelOutput, error) {
+	input := bedrock.GetImportedModelInput{
+		ModelIdentifier: aws.String(id),
+	}
+
+	output, err := conn.GetImportedModel(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func findModelImportJobByID(ctx context.Context, conn *bedrock.Client, id string) (*bedrock.GetModelImportJobOutput, error) {
+	input := bedrock.GetModelImportJobInput{
+		JobIdentifier: aws.String(id),
+	}
+
+	output, err := conn.GetModelImportJob(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusModelImportJob(ctx context.Context, conn *bedrock.Client, id string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, err := findModelImportJobByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitModelImportJobCompleted(ctx context.Context, conn *bedrock.Client, id string, timeout time.Duration) (*bedrock.GetModelImportJobOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.ModelImportJobStatusInProgress),
+		Target:  enum.Slice(awstypes.ModelImportJobStatusCompleted),
+		Refresh: statusModelImportJob(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*bedrock.GetModelImportJobOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.FailureMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+type importedModelResourceModel struct {
+	ARN                   types.String                                          `tfsdk:"arn" autoflex:"-"`
+	CreationTime          timetypes.RFC3339                                     `tfsdk:"creation_time"`
+	ID                    types.String                                          `tfsdk:"id" autoflex:"-"`
+	ImportedModelKmsKeyID fwtypes.ARN                                           `tfsdk:"imported_model_kms_key_id"`
+	ImportedModelName     types.String                                          `tfsdk:"imported_model_name"`
+	InstructSupported     types.Bool                                            `tfsdk:"instruct_supported"`
+	JobARN                types.String                                          `tfsdk:"job_arn"`
+	JobName               types.String                                          `tfsdk:"job_name"`
+	ModelArchitecture     types.String                                          `tfsdk:"model_architecture"`
+	ModelDataSource       fwtypes.ListNestedObjectValueOf[modelDataSourceModel] `tfsdk:"model_data_source"`
+	RoleARN               fwtypes.ARN                                           `tfsdk:"role_arn"`
+	Tags                  tftags.Map                                            `tfsdk:"tags"`
+	TagsAll               tftags.Map                                            `tfsdk:"tags_all"`
+	Timeouts              timeouts.Value                                        `tfsdk:"timeouts"`
+	VPCConfig             fwtypes.ListNestedObjectValueOf[vpcConfigModel]       `tfsdk:"vpc_config"`
+}
+
+func (data *importedModelResourceModel) flattenImportedModel(ctx context.Context, output *bedrock.GetImportedModelOutput) (diags diag.Diagnostics) {
+	diags.Append(fwflex.Flatten(ctx, output, data)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	// Some fields in GetImportedModelOutput have different names than in CreateModelImportJobInput.
+	data.ARN = fwflex.StringToFramework(ctx, output.ModelArn)
+	data.ID = data.ARN
+	data.ImportedModelKmsKeyID = fwflex.StringToFrameworkARN(ctx, output.ModelKmsKeyArn)
+	data.ImportedModelName = fwflex.StringToFramework(ctx, output.ModelName)
+
+	return diags
+}
+
+// Tagged union.
+type modelDataSourceModel struct {
+	S3DataSource fwtypes.ListNestedObjectValueOf[s3DataSourceModel] `tfsdk:"s3_data_source"`
+}
+
+var (
+	_ fwflex.Expander  = modelDataSourceModel{}
+	_ fwflex.Flattener = &modelDataSourceModel{}
+)
+
+func (m modelDataSourceModel) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
+	switch {
+	case !m.S3DataSource.IsNull():
+		s3DataSourceData, d := m.S3DataSource.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.ModelDataSourceMemberS3DataSource
+		diags.Append(fwflex.Expand(ctx, s3DataSourceData, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+	}
+
+	return nil, diags
+}
+
+func (m *modelDataSourceModel) Flatten(ctx context.Context, v any) (diags diag.Diagnostics) {
+	switch t := v.(type) {
+	case awstypes.ModelDataSourceMemberS3DataSource:
+		var model s3DataSourceModel
+		diags.Append(fwflex.Flatten(ctx, t.Value, &model)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.S3DataSource = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+
+		return diags
+	}
+
+	return diags
+}
+
+type s3DataSourceModel struct {
+	S3URI types.String `tfsdk:"s3_uri"`
+}
@@ -0,0 +1,665 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package bedrock
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/bedrock"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/bedrock/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_bedrock_model_invocation_job", name="Model Invocation Job")
+// @Tags(identifierAttribute="job_arn")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/bedrock;bedrock.GetModelInvocationJobOutput")
+func newModelInvocationJobResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &modelInvocationJobResource{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+	r.SetDefaultDeleteTimeout(60 * time.Minute)
+
+	return r, nil
+}
+
+type modelInvocationJobResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (r *modelInvocationJobResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"end_time": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"job_arn":    framework.ARNAttributeComputedOnly(),
+			"job_expiration_time": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			"job_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 63),
+					stringvalidator.RegexMatches(regexache.MustCompile(`^[a-zA-Z0-9](-*[a-zA-Z0-9\+\-\.])*$`),
+						"must be up to 63 letters (uppercase and lowercase), numbers, plus sign, dashes, and dots, and must start with an alphanumeric"),
+				},
+			},
+			"last_modified_time": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			names.AttrMessage: schema.StringAttribute{
+				Computed: true,
+			},
+			"model_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrRoleARN: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ModelInvocationJobStatus](),
+				Computed:   true,
+			},
+			"submit_time": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"timeout_duration_in_hours": schema.Int32Attribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.RequiresReplace(),
+					int32planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int32{
+					int32validator.Between(24, 168),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"input_data_config": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[modelInvocationJobInputDataConfigModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"s3_input_data_config": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[modelInvocationJobS3InputDataConfigModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtLeast(1),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"s3_bucket_owner": schema.StringAttribute{
+										Optional: true,
+										Validators: []validator.String{
+											fwvalidators.AWSAccountID(),
+										},
+									},
+									"s3_input_format": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.S3InputFormat](),
+										Optional:   true,
+										Computed:   true,
+										PlanModifiers: []planmodifier.String{
+											stringplanmodifier.UseStateForUnknown(),
+										},
+									},
+									"s3_uri": schema.StringAttribute{
+										Required: true,
+										Validators: []validator.String{
+											fwvalidators.S3URI(),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"output_data_config": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[modelInvocationJobOutputDataConfigModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"s3_output_data_config": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[modelInvocationJobS3OutputDataConfigModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtLeast(1),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"s3_bucket_owner": schema.StringAttribute{
+										Optional: true,
+										Validators: []validator.String{
+											fwvalidators.AWSAccountID(),
+										},
+									},
+									"s3_encryption_key_id": schema.StringAttribute{
+										Optional: true,
+									},
+									"s3_uri": schema.StringAttribute{
+										Required: true,
+										Validators: []validator.String{
+											fwvalidators.S3URI(),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Delete: true,
+			}),
+			names.AttrVPCConfig: schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[vpcConfigModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrSecurityGroupIDs: schema.SetAttribute{
+							CustomType:  fwtypes.SetOfStringType,
+							Required:    true,
+							ElementType: types.StringType,
+							PlanModifiers: []planmodifier.Set{
+								setplanmodifier.RequiresReplace(),
+							},
+						},
+						names.AttrSubnetIDs: schema.SetAttribute{
+							CustomType:  fwtypes.SetOfStringType,
+							Required:    true,
+							ElementType: types.StringType,
+							PlanModifiers: []planmodifier.Set{
+								setplanmodifier.RequiresReplace(),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *modelInvocationJobResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data modelInvocationJobResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BedrockClient(ctx)
+
+	name := data.JobName.ValueString()
+	var input bedrock.CreateModelInvocationJobInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ClientRequestToken = aws.String(id.UniqueId())
+	input.Tags = getTagsIn(ctx)
+
+	outputRaw, err := tfresource.RetryWhenAWSErrMessageContains(ctx, propagationTimeout, func() (any, error) {
+		return conn.CreateModelInvocationJob(ctx, &input)
+	}, errCodeValidationException, "Could not assume provided IAM role")
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Bedrock Model Invocation Job (%s)", name), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.JobARN = fwflex.StringToFramework(ctx, outputRaw.(*bedrock.CreateModelInvocationJobOutput).JobArn)
+	data.setID()
+
+	job, err := waitModelInvocationJobSubmitted(ctx, conn, data.ID.ValueString(), r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Bedrock Model Invocation Job (%s) create", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, job, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *modelInvocationJobResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data modelInvocationJobResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().BedrockClient(ctx)
+
+	output, err := findModelInvocationJobByID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Bedrock Model Invocation Job (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *modelInvocationJobResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new modelInvocationJobResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Update is only called when `tags` are updated.
+	// Set unknowns to the old (in state) values.
+	new.EndTime = old.EndTime
+	new.JobExpirationTime = old.JobExpirationTime
+	new.LastModifiedTime = old.LastModifiedTime
+	new.Message = old.Message
+	new.Status = old.Status
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *modelInvocationJobResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data modelInvocationJobResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BedrockClient(ctx)
+
+	// Model invocation jobs cannot be deleted, only stopped.
+	switch data.Status.ValueEnum() {
+	case awstypes.ModelInvocationJobStatusSubmitted,
+		awstypes.ModelInvocationJobStatusValidating,
+		awstypes.ModelInvocationJobStatusScheduled,
+		awstypes.ModelInvocationJobStatusInProgress:
+	default:
+		return
+	}
+
+	jobARN := data.ID.ValueString()
+	input := bedrock.StopModelInvocationJobInput{
+		JobIdentifier: aws.String(jobARN),
+	}
+	_, err := conn.StopModelInvocationJob(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	// "ValidationException: The job is not in a state that can be stopped".
+	if errs.IsA[*awstypes.ValidationException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("stopping Bedrock Model Invocation Job (%s)", jobARN), err.Error())
+
+		return
+	}
+
+	if _, err := waitModelInvocationJobStopped(ctx, conn, jobARN, r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Bedrock Model Invocation Job (%s) stop", jobARN), err.Error())
+
+		return
+	}
+}
+
+func findModelInvocationJobByID(ctx context.Context, conn *bedrock.Client, id string) (*bedrock.GetModelInvocationJobOutput, error) {
+	input := bedrock.GetModelInvocationJobInput{
+		JobIdentifier: aws.String(id),
+	}
+
+	output, err := findModelInvocationJob(ctx, conn, &input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if status := output.Status; status == awstypes.ModelInvocationJobStatusStopped {
+		return nil, &retry.NotFoundError{
+			Message:     string(status),
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func findModelInvocationJob(ctx context.Context, conn *bedrock.Client, input *bedrock.GetModelInvocationJobInput) (*bedrock.GetModelInvocationJobOutput, error) {
+	output, err := conn.GetModelInvocationJob(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusModelInvocationJob(ctx context.Context, conn *bedrock.Client, id string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		input := bedrock.GetModelInvocationJobInput{
+			JobIdentifier: aws.String(id),
+		}
+		output, err := findModelInvocationJob(ctx, conn, &input)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitModelInvocationJobSubmitted(ctx context.Context, conn *bedrock.Client, id string, timeout time.Duration) (*bedrock.GetModelInvocationJobOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.ModelInvocationJobStatusSubmitted, awstypes.ModelInvocationJobStatusValidating),
+		Target: enum.Slice(
+			awstypes.ModelInvocationJobStatusScheduled,
+			awstypes.ModelInvocationJobStatusInProgress,
+			awstypes.ModelInvocationJobStatusCompleted,
+			awstypes.ModelInvocationJobStatusPartiallyCompleted,
+		),
+		Refresh: statusModelInvocationJob(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*bedrock.GetModelInvocationJobOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.Message)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitModelInvocationJobStopped(ctx context.Context, conn *bedrock.Client, id string, timeout time.Duration) (*bedrock.GetModelInvocationJobOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(
+			awstypes.ModelInvocationJobStatusSubmitted,
+			awstypes.ModelInvocationJobStatusValidating,
+			awstypes.ModelInvocationJobStatusScheduled,
+			awstypes.ModelInvocationJobStatusInProgress,
+			awstypes.ModelInvocationJobStatusStopping,
+		),
+		Target:  enum.Slice(awstypes.ModelInvocationJobStatusStopped),
+		Refresh: statusModelInvocationJob(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*bedrock.GetModelInvocationJobOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.Message)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+type modelInvocationJobResourceModel struct {
+	EndTime                timetypes.RFC3339                                                        `tfsdk:"end_time"`
+	ID                     types.String                                                             `tfsdk:"id"`
+	InputDataConfig        fwtypes.ListNestedObjectValueOf[modelInvocationJobInputDataConfigModel]  `tfsdk:"input_data_config"`
+	JobARN                 types.String                                                             `tfsdk:"job_arn"`
+	JobExpirationTime      timetypes.RFC3339                                                        `tfsdk:"job_expiration_time"`
+	JobName                types.String                                                             `tfsdk:"job_name"`
+	LastModifiedTime       timetypes.RFC3339                                                        `tfsdk:"last_modified_time"`
+	Message                types.String                                                             `tfsdk:"message"`
+	ModelID                types.String                                                             `tfsdk:"model_id"`
+	OutputDataConfig       fwtypes.ListNestedObjectValueOf[modelInvocationJobOutputDataConfigModel] `tfsdk:"output_data_config"`
+	RoleARN                fwtypes.ARN                                                              `tfsdk:"role_arn"`
+	Status                 fwtypes.StringEnum[awstypes.ModelInvocationJobStatus]                    `tfsdk:"status"`
+	SubmitTime             timetypes.RFC3339                                                        `tfsdk:"submit_time"`
+	Tags                   tftags.Map                                                               `tfsdk:"tags"`
+	TagsAll                tftags.Map                                                               `tfsdk:"tags_all"`
+	TimeoutDurationInHours types.Int32                                                              `tfsdk:"timeout_duration_in_hours"`
+	Timeouts               timeouts.Value                                                           `tfsdk:"timeouts"`
+	VPCConfig              fwtypes.ListNestedObjectValueOf[vpcConfigModel]                          `tfsdk:"vpc_config"`
+}
+
+func (data *modelInvocationJobResourceModel) InitFromID() error {
+	data.JobARN = data.ID
+
+	return nil
+}
+
+func (data *modelInvocationJobResourceModel) setID() {
+	data.ID = data.JobARN
+}
+
+// Tagged union.
+type modelInvocationJobInputDataConfigModel struct {
+	S3InputDataConfig fwtypes.ListNestedObjectValueOf[modelInvocationJobS3InputDataConfigModel] `tfsdk:"s3_input_data_config"`
+}
+
+var (
+	_ fwflex.Expander  = modelInvocationJobInputDataConfigModel{}
+	_ fwflex.Flattener = &modelInvocationJobInputDataConfigModel{}
+)
+
+func (m modelInvocationJobInputDataConfigModel) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
+	switch {
+	case !m.S3InputDataConfig.IsNull():
+		modelInvocationJobS3InputDataConfigData, d := m.S3InputDataConfig.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.ModelInvocationJobInputDataConfigMemberS3InputDataConfig
+		diags.Append(fwflex.Expand(ctx, modelInvocationJobS3InputDataConfigData, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+	}
+
+	return nil, diags
+}
+
+func (m *modelInvocationJobInputDataConfigModel) Flatten(ctx context.Context, v any) (diags diag.Diagnostics) {
+	switch t := v.(type) {
+	case awstypes.ModelInvocationJobInputDataConfigMemberS3InputDataConfig:
+		var model modelInvocationJobS3InputDataConfigModel
+		diags.Append(fwflex.Flatten(ctx, t.Value, &model)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.S3InputDataConfig = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+
+		return diags
+	}
+
+	return diags
+}
+
+type modelInvocationJobS3InputDataConfigModel struct {
+	S3BucketOwner types.String                               `tfsdk:"s3_bucket_owner"`
+	S3InputFormat fwtypes.StringEnum[awstypes.S3InputFormat] `tfsdk:"s3_input_format"`
+	S3URI         types.String                               `tfsdk:"s3_uri"`
+}
+
+// Tagged union.
+type modelInvocationJobOutputDataConfigModel struct {
+	S3OutputDataConfig fwtypes.ListNestedObjectValueOf[modelInvocationJobS3OutputDataConfigModel] `tfsdk:"s3_output_data_config"`
+}
+
+var (
+	_ fwflex.Expander  = modelInvocationJobOutputDataConfigModel{}
+	_ fwflex.Flattener = &modelInvocationJobOutputDataConfigModel{}
+)
+
+func (m modelInvocationJobOutputDataConfigModel) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
+	switch {
+	case !m.S3OutputDataConfig.IsNull():
+		modelInvocationJobS3OutputDataConfigData, d := m.S3OutputDataConfig.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.ModelInvocationJobOutputDataConfigMemberS3OutputDataConfig
+		diags.Append(fwflex.Expand(ctx, modelInvocationJobS3OutputDataConfigData, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+	}
+
+	return nil, diags
+}
+
+func (m *modelInvocationJobOutputDataConfigModel) Flatten(ctx context.Context, v any) (diags diag.Diagnostics) {
+	switch t := v.(type) {
+	case awstypes.ModelInvocationJobOutputDataConfigMemberS3OutputDataConfig:
+		var model modelInvocationJobS3OutputDataConfigModel
+		diags.Append(fwflex.Flatten(ctx, t.Value, &model)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.S3OutputDataConfig = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+
+		return diags
+	}
+
+	return diags
+}
+
+type modelInvocationJobS3OutputDataConfigModel struct {
+	S3BucketOwner     types.String `tfsdk:"s3_bucket_owner"`
+	S3EncryptionKeyID types.String `tfsdk:"s3_encryption_key_id"`
+	S3URI             types.String `tfsdk:"s3_uri"`
+}
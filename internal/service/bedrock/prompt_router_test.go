@@ -0,0 +1,268 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package bedrock_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/bedrock"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfbedrock "github.com/hashicorp/terraform-provider-aws/internal/service/bedrock"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccBedrockPromptRouter_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_bedrock_prompt_router.test"
+	var v bedrock.GetPromptRouterOutput
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.BedrockEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.BedrockServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPromptRouterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPromptRouterConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPromptRouterExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrARN),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrCreatedAt),
+					resource.TestCheckNoResourceAttr(resourceName, names.AttrDescription),
+					resource.TestCheckResourceAttr(resourceName, "fallback_model.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "model.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "prompt_router_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "routing_criteria.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "routing_criteria.0.response_quality_difference", "25"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "AVAILABLE"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "0"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrType, "custom"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccBedrockPromptRouter_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_bedrock_prompt_router.test"
+	var v bedrock.GetPromptRouterOutput
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.BedrockEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.BedrockServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPromptRouterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPromptRouterConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPromptRouterExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfbedrock.ResourcePromptRouter, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccBedrockPromptRouter_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_bedrock_prompt_router.test"
+	var v bedrock.GetPromptRouterOutput
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.BedrockEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.BedrockServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPromptRouterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPromptRouterConfig_tags1(rName, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPromptRouterExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "1"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPromptRouterConfig_tags2(rName, acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPromptRouterExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "2"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1Updated),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+			{
+				Config: testAccPromptRouterConfig_tags1(rName, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPromptRouterExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "1"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPromptRouterDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).BedrockClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_bedrock_prompt_router" {
+				continue
+			}
+
+			_, err := tfbedrock.FindPromptRouterByARN(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Bedrock Prompt Router %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckPromptRouterExists(ctx context.Context, n string, v *bedrock.GetPromptRouterOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).BedrockClient(ctx)
+
+		output, err := tfbedrock.FindPromptRouterByARN(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccPromptRouterConfig_base() string {
+	return `
+data "aws_bedrock_inference_profile" "haiku" {
+  inference_profile_id = "us.anthropic.claude-3-haiku-20240307-v1:0"
+}
+
+data "aws_bedrock_inference_profile" "sonnet" {
+  inference_profile_id = "us.anthropic.claude-3-5-sonnet-20240620-v1:0"
+}
+`
+}
+
+func testAccPromptRouterConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccPromptRouterConfig_base(), fmt.Sprintf(`
+resource "aws_bedrock_prompt_router" "test" {
+  prompt_router_name = %[1]q
+
+  model {
+    model_arn = data.aws_bedrock_inference_profile.haiku.inference_profile_arn
+  }
+
+  model {
+    model_arn = data.aws_bedrock_inference_profile.sonnet.inference_profile_arn
+  }
+
+  fallback_model {
+    model_arn = data.aws_bedrock_inference_profile.haiku.inference_profile_arn
+  }
+
+  routing_criteria {
+    response_quality_difference = 25
+  }
+}
+`, rName))
+}
+
+func testAccPromptRouterConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccPromptRouterConfig_base(), fmt.Sprintf(`
+resource "aws_bedrock_prompt_router" "test" {
+  prompt_router_name = %[1]q
+
+  model {
+    model_arn = data.aws_bedrock_inference_profile.haiku.inference_profile_arn
+  }
+
+  model {
+    model_arn = data.aws_bedrock_inference_profile.sonnet.inference_profile_arn
+  }
+
+  fallback_model {
+    model_arn = data.aws_bedrock_inference_profile.haiku.inference_profile_arn
+  }
+
+  routing_criteria {
+    response_quality_difference = 25
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccPromptRouterConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccPromptRouterConfig_base(), fmt.Sprintf(`
+resource "aws_bedrock_prompt_router" "test" {
+  prompt_router_name = %[1]q
+
+  model {
+    model_arn = data.aws_bedrock_inference_profile.haiku.inference_profile_arn
+  }
+
+  model {
+    model_arn = data.aws_bedrock_inference_profile.sonnet.inference_profile_arn
+  }
+
+  fallback_model {
+    model_arn = data.aws_bedrock_inference_profile.haiku.inference_profile_arn
+  }
+
+  routing_criteria {
+    response_quality_difference = 25
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -59,6 +59,14 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 				IdentifierAttribute: "job_arn",
 			},
 		},
+		{
+			Factory:  newEvaluationJobResource,
+			TypeName: "aws_bedrock_evaluation_job",
+			Name:     "Evaluation Job",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
 		{
 			Factory:  newResourceGuardrail,
 			TypeName: "aws_bedrock_guardrail",
@@ -72,6 +80,14 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			TypeName: "aws_bedrock_guardrail_version",
 			Name:     "Guardrail Version",
 		},
+		{
+			Factory:  newImportedModelResource,
+			TypeName: "aws_bedrock_imported_model",
+			Name:     "Imported Model",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
 		{
 			Factory:  newResourceInferenceProfile,
 			TypeName: "aws_bedrock_inference_profile",
@@ -80,6 +96,14 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 				IdentifierAttribute: names.AttrARN,
 			},
 		},
+		{
+			Factory:  newModelInvocationJobResource,
+			TypeName: "aws_bedrock_model_invocation_job",
+			Name:     "Model Invocation Job",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "job_arn",
+			},
+		},
 		{
 			Factory:  newModelInvocationLoggingConfigurationResource,
 			TypeName: "aws_bedrock_model_invocation_logging_configuration",
@@ -93,6 +117,14 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 				IdentifierAttribute: "provisioned_model_arn",
 			},
 		},
+		{
+			Factory:  newPromptRouterResource,
+			TypeName: "aws_bedrock_prompt_router",
+			Name:     "Prompt Router",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
 	}
 }
 
@@ -8,6 +8,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+	"reflect"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/YakDriver/regexache"
@@ -244,15 +247,29 @@ func resourceResourceDelete(ctx context.Context, d *schema.ResourceData, meta an
 func resourceResourceCustomizeDiffGetSchema(ctx context.Context, diff *schema.ResourceDiff, meta any) error {
 	conn := meta.(*conns.AWSClient).CloudFormationClient(ctx)
 
+	// A schema set in configuration is always used as-is.
+	if !diff.GetRawConfig().GetAttr(names.AttrSchema).IsNull() {
+		return nil
+	}
+
 	resourceSchema := diff.Get(names.AttrSchema).(string)
 
-	if resourceSchema != "" {
+	// The schema is re-read when the resource type version changes.
+	if resourceSchema != "" && !diff.HasChange("type_version_id") {
+		return nil
+	}
+
+	if !diff.NewValueKnown("type_version_id") {
+		if err := diff.SetNewComputed(names.AttrSchema); err != nil {
+			return fmt.Errorf("setting schema NewComputed: %w", err)
+		}
+
 		return nil
 	}
 
 	typeName := diff.Get("type_name").(string)
 
-	output, err := tfcloudformation.FindTypeByName(ctx, conn, typeName)
+	output, err := tfcloudformation.FindTypeByTwoPartKey(ctx, conn, typeName, diff.Get("type_version_id").(string))
 
 	if err != nil {
 		return fmt.Errorf("reading CloudFormation Type (%s): %w", typeName, err)
@@ -280,6 +297,11 @@ func resourceResourceCustomizeDiffSchemaDiff(ctx context.Context, diff *schema.R
 		return nil
 	}
 
+	// schema can be empty if unknown
+	if newSchema == "" {
+		return nil
+	}
+
 	newSchema, err := cfschema.Sanitize(newSchema)
 
 	if err != nil {
@@ -296,25 +318,39 @@ func resourceResourceCustomizeDiffSchemaDiff(ctx context.Context, diff *schema.R
 		return fmt.Errorf("validating desired_state against CloudFormation Resource Schema: %w", err)
 	}
 
-	// Do nothing further for new resources or if desired state is not changed
-	if diff.Id() == "" || !diff.HasChange("desired_state") {
-		return nil
-	}
-
 	cfResource, err := cfResourceSchema.Resource()
 
 	if err != nil {
 		return fmt.Errorf("converting CloudFormation Resource Schema JSON: %w", err)
 	}
 
-	patches, err := jsonpatch.CreatePatch([]byte(oldDesiredStateRaw.(string)), []byte(newDesiredStateRaw.(string)))
+	var newDocument any
+	if err := json.Unmarshal([]byte(newDesiredState), &newDocument); err != nil {
+		return fmt.Errorf("parsing desired_state JSON: %w", err)
+	}
 
-	if err != nil {
-		return fmt.Errorf("creating desired_state JSON Patch: %w", err)
+	// Read-only properties are set by the resource type's handlers and are rejected by Cloud Control API.
+	for _, v := range cfResource.ReadOnlyProperties {
+		if path := propertyPath(v); len(propertyPathValues(newDocument, path)) > 0 {
+			return fmt.Errorf("desired_state must not contain read-only property %s", v)
+		}
 	}
 
-	for _, patch := range patches {
-		if cfResource.IsCreateOnlyPropertyPath(patch.Path) {
+	// Do nothing further for new resources or if desired state is not changed
+	if diff.Id() == "" || !diff.HasChange("desired_state") {
+		return nil
+	}
+
+	var oldDocument any
+	if err := json.Unmarshal([]byte(oldDesiredStateRaw.(string)), &oldDocument); err != nil {
+		return fmt.Errorf("parsing desired_state JSON: %w", err)
+	}
+
+	// Any change to a create-only property, including the addition or removal of a nested value, requires replacement.
+	for _, v := range cfResource.CreateOnlyProperties {
+		path := propertyPath(v)
+
+		if !reflect.DeepEqual(propertyPathValues(oldDocument, path), propertyPathValues(newDocument, path)) {
 			if err := diff.ForceNew("desired_state"); err != nil {
 				return fmt.Errorf("setting desired_state ForceNew: %w", err)
 			}
@@ -326,6 +362,52 @@ func resourceResourceCustomizeDiffSchemaDiff(ctx context.Context, diff *schema.R
 	return nil
 }
 
+// propertyPath returns the segments of a resource schema property JSON pointer, e.g. "/properties/Tags/*/Key",
+// relative to the resource's properties.
+func propertyPath(pointer cfschema.PropertyJsonPointer) []string {
+	path := strings.TrimPrefix(string(pointer), "/properties")
+	path = strings.TrimPrefix(path, "/")
+
+	if path == "" {
+		return nil
+	}
+
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		// https://datatracker.ietf.org/doc/html/rfc6901#section-4.
+		segments[i] = strings.ReplaceAll(strings.ReplaceAll(segment, "~1", "/"), "~0", "~")
+	}
+
+	return segments
+}
+
+// propertyPathValues returns the values in the JSON document at the specified property path.
+// A "*" path segment matches all elements of an array.
+func propertyPathValues(document any, path []string) []any {
+	if len(path) == 0 {
+		return []any{document}
+	}
+
+	var values []any
+
+	switch v := document.(type) {
+	case map[string]any:
+		if value, ok := v[path[0]]; ok {
+			values = append(values, propertyPathValues(value, path[1:])...)
+		}
+	case []any:
+		if path[0] == "*" {
+			for _, value := range v {
+				values = append(values, propertyPathValues(value, path[1:])...)
+			}
+		} else if i, err := strconv.Atoi(path[0]); err == nil && i >= 0 && i < len(v) {
+			values = append(values, propertyPathValues(v[i], path[1:])...)
+		}
+	}
+
+	return values
+}
+
 func findResource(ctx context.Context, conn *cloudcontrol.Client, resourceID, typeName, typeVersionID, roleARN string) (*types.ResourceDescription, error) {
 	input := &cloudcontrol.GetResourceInput{
 		Identifier: aws.String(resourceID),
@@ -300,6 +300,24 @@ func TestAccCloudControlResource_DesiredState_invalidPropertyValue(t *testing.T)
 	})
 }
 
+func TestAccCloudControlResource_DesiredState_readOnly(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudControlServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckResourceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccResourceConfig_desiredStateReadOnly(rName),
+				ExpectError: regexache.MustCompile(`desired_state must not contain read-only property /properties/Arn`),
+			},
+		},
+	})
+}
+
 func TestAccCloudControlResource_DesiredState_objectValueAdded(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -645,6 +663,25 @@ resource "aws_cloudcontrolapi_resource" "test" {
 `, rName)
 }
 
+func testAccResourceConfig_desiredStateReadOnly(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudcontrolapi_resource" "test" {
+  type_name = "AWS::Logs::LogGroup"
+
+  desired_state = jsonencode({
+    Arn          = "arn:${data.aws_partition.current.partition}:logs:${data.aws_region.current.name}:${data.aws_caller_identity.current.account_id}:log-group:%[1]s:*"
+    LogGroupName = %[1]q
+  })
+}
+
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+data "aws_region" "current" {}
+`, rName)
+}
+
 func testAccResourceConfig_desiredStateObjectValue1(rName string, key1 string, value1 string) string {
 	return fmt.Sprintf(`
 resource "aws_cloudcontrolapi_resource" "test" {
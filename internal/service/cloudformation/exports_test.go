@@ -11,6 +11,7 @@ var (
 	ResourceStackInstances   = resourceStackInstances
 	ResourceType             = resourceType
 
+	ChangeSetDifferences                    = changeSetDifferences
 	FindStackInstanceByFourPartKey          = findStackInstanceByFourPartKey
 	FindStackInstanceSummariesByFourPartKey = findStackInstanceSummariesByFourPartKey
 	FindStackSetByName                      = findStackSetByName
@@ -4,8 +4,12 @@
 package cloudformation
 
 import (
+	"slices"
+	"strings"
+
 	"github.com/aws/aws-sdk-go-v2/aws"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 func expandParameters(params map[string]any) []awstypes.Parameter {
@@ -50,3 +54,27 @@ func flattenParameters(cfParams []awstypes.Parameter,
 	}
 	return params
 }
+
+func flattenChanges(changes []awstypes.Change) []any {
+	tfList := make([]any, 0, len(changes))
+	for _, change := range changes {
+		v := change.ResourceChange
+		if v == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]any{
+			names.AttrAction:       string(v.Action),
+			"logical_resource_id":  aws.ToString(v.LogicalResourceId),
+			"physical_resource_id": aws.ToString(v.PhysicalResourceId),
+			"replacement":          string(v.Replacement),
+			names.AttrResourceType: aws.ToString(v.ResourceType),
+		})
+	}
+
+	slices.SortFunc(tfList, func(a, b any) int {
+		return strings.Compare(a.(map[string]any)["logical_resource_id"].(string), b.(map[string]any)["logical_resource_id"].(string))
+	})
+
+	return tfList
+}
@@ -8,6 +8,8 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"maps"
+	"slices"
 	"strings"
 	"time"
 
@@ -26,6 +28,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -91,6 +94,39 @@ func resourceStack() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"planned_changes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrAction: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"logical_resource_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"physical_resource_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"replacement": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrResourceType: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"planned_change_warnings": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			names.AttrParameters: {
 				Type:     schema.TypeMap,
 				Optional: true,
@@ -111,6 +147,10 @@ func resourceStack() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"preview_changes": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 			"template_body": {
@@ -136,6 +176,7 @@ func resourceStack() *schema.Resource {
 
 		CustomizeDiff: customdiff.All(
 			customdiff.ComputedIf("outputs", stackHasActualChanges),
+			stackPreviewChanges,
 		),
 	}
 }
@@ -278,6 +319,18 @@ func resourceStackUpdate(ctx context.Context, d *schema.ResourceData, meta any)
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).CloudFormationClient(ctx)
 
+	if !d.HasChangesExcept("planned_change_warnings", "planned_changes", "preview_changes") {
+		return append(diags, resourceStackRead(ctx, d, meta)...)
+	}
+
+	if d.Get("preview_changes").(bool) {
+		if err := updateStackWithChangeSet(ctx, conn, d, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating CloudFormation Stack (%s): %s", d.Id(), err)
+		}
+
+		return append(diags, resourceStackRead(ctx, d, meta)...)
+	}
+
 	requestToken := id.UniqueId()
 	input := &cloudformation.UpdateStackInput{
 		ClientRequestToken: aws.String(requestToken),
@@ -344,6 +397,96 @@ func resourceStackUpdate(ctx context.Context, d *schema.ResourceData, meta any)
 	return append(diags, resourceStackRead(ctx, d, meta)...)
 }
 
+// updateStackWithChangeSet updates the stack by creating and executing a change set.
+// The change set is checked against the changes previewed at plan time so that
+// the stack is never changed in a way that wasn't shown in the plan.
+func updateStackWithChangeSet(ctx context.Context, conn *cloudformation.Client, d *schema.ResourceData, timeout time.Duration) error {
+	changeSetName := id.PrefixedUniqueId(changeSetNamePrefixApply)
+	input, err := expandCreateChangeSetInput(d, changeSetName, getTagsIn(ctx))
+	if err != nil {
+		return err
+	}
+
+	changes, err := createChangeSetAndDescribeChanges(ctx, conn, input)
+	if err != nil {
+		return fmt.Errorf("creating change set (%s): %w", changeSetName, err)
+	}
+
+	if changes == nil {
+		if err := deleteChangeSet(ctx, conn, d.Id(), changeSetName); err != nil {
+			return fmt.Errorf("deleting change set (%s): %w", changeSetName, err)
+		}
+
+		return updateStackPolicy(ctx, conn, d)
+	}
+
+	tfList := flattenChanges(changes)
+	if planned := d.GetRawPlan().GetAttr("planned_changes"); planned.IsKnown() {
+		if differences := changeSetDifferences(d.Get("planned_changes").([]any), tfList); len(differences) > 0 {
+			if err := deleteChangeSet(ctx, conn, d.Id(), changeSetName); err != nil {
+				return fmt.Errorf("deleting change set (%s): %w", changeSetName, err)
+			}
+
+			return fmt.Errorf("change set (%s) differs from the changes previewed in the plan, run plan again to review them: %s", changeSetName, strings.Join(differences, ", "))
+		}
+	} else {
+		if err := d.Set("planned_changes", tfList); err != nil {
+			return fmt.Errorf("setting planned_changes: %w", err)
+		}
+		if err := d.Set("planned_change_warnings", changeWarnings(tfList)); err != nil {
+			return fmt.Errorf("setting planned_change_warnings: %w", err)
+		}
+	}
+
+	if err := updateStackPolicy(ctx, conn, d); err != nil {
+		return err
+	}
+
+	requestToken := id.UniqueId()
+	executeInput := &cloudformation.ExecuteChangeSetInput{
+		ChangeSetName:      aws.String(changeSetName),
+		ClientRequestToken: aws.String(requestToken),
+		StackName:          aws.String(d.Id()),
+	}
+
+	if _, err := conn.ExecuteChangeSet(ctx, executeInput); err != nil {
+		return fmt.Errorf("executing change set (%s): %w", changeSetName, err)
+	}
+
+	if _, err := waitStackUpdated(ctx, conn, d.Id(), requestToken, timeout); err != nil {
+		return fmt.Errorf("waiting for update: %w", err)
+	}
+
+	return nil
+}
+
+// updateStackPolicy sets the stack policy, which can't be set via a change set.
+func updateStackPolicy(ctx context.Context, conn *cloudformation.Client, d *schema.ResourceData) error {
+	if !d.HasChanges("policy_body", "policy_url") {
+		return nil
+	}
+
+	input := &cloudformation.SetStackPolicyInput{
+		StackName: aws.String(d.Id()),
+	}
+
+	if v, ok := d.GetOk("policy_url"); ok {
+		input.StackPolicyURL = aws.String(v.(string))
+	} else {
+		policy, err := structure.NormalizeJsonString(d.Get("policy_body"))
+		if err != nil {
+			return err
+		}
+		input.StackPolicyBody = aws.String(policy)
+	}
+
+	if _, err := conn.SetStackPolicy(ctx, input); err != nil {
+		return fmt.Errorf("setting stack policy: %w", err)
+	}
+
+	return nil
+}
+
 func resourceStackDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).CloudFormationClient(ctx)
@@ -676,6 +819,9 @@ func stackHasActualChanges(ctx context.Context, d *schema.ResourceDiff, meta any
 		if attr.ForceNew {
 			continue
 		}
+		if k == "preview_changes" {
+			continue
+		}
 		if attr.Computed && !attr.Optional {
 			continue
 		}
@@ -694,3 +840,168 @@ func stackHasActualChanges(ctx context.Context, d *schema.ResourceDiff, meta any
 	}
 	return false
 }
+
+// stackPreviewChanges creates a change set at plan time, records its resource changes
+// in planned_changes and any resource removals and replacements in planned_change_warnings, and deletes it again.
+func stackPreviewChanges(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	if d.Id() == "" || !d.Get("preview_changes").(bool) {
+		return nil
+	}
+
+	if !stackHasActualChanges(ctx, d, meta) {
+		return nil
+	}
+
+	for _, key := range []string{"capabilities", names.AttrIAMRoleARN, "notification_arns", names.AttrParameters, names.AttrTagsAll, "template_body", "template_url"} {
+		if !d.NewValueKnown(key) {
+			if err := d.SetNewComputed("planned_change_warnings"); err != nil {
+				return err
+			}
+
+			return d.SetNewComputed("planned_changes")
+		}
+	}
+
+	conn := meta.(*conns.AWSClient).CloudFormationClient(ctx)
+
+	changeSetName := id.PrefixedUniqueId(changeSetNamePrefixPreview)
+	input, err := expandCreateChangeSetInput(d, changeSetName, svcTags(tftags.New(ctx, d.Get(names.AttrTagsAll))))
+	if err != nil {
+		return err
+	}
+
+	changes, err := createChangeSetAndDescribeChanges(ctx, conn, input)
+
+	if err := deleteChangeSet(ctx, conn, d.Id(), changeSetName); err != nil {
+		log.Printf("[WARN] Deleting CloudFormation Stack (%s) change set (%s): %s", d.Id(), changeSetName, err)
+	}
+
+	if err != nil {
+		return fmt.Errorf("previewing CloudFormation Stack (%s) changes: %w", d.Id(), err)
+	}
+
+	tfList := flattenChanges(changes)
+	if err := d.SetNew("planned_change_warnings", changeWarnings(tfList)); err != nil {
+		return err
+	}
+
+	return d.SetNew("planned_changes", tfList)
+}
+
+// changeWarnings returns warnings for the resource removals and replacements in the specified flattened changes.
+func changeWarnings(tfList []any) []any {
+	warnings := make([]any, 0)
+
+	for _, tfMapRaw := range tfList {
+		tfMap := tfMapRaw.(map[string]any)
+
+		switch action, replacement := tfMap[names.AttrAction].(string), tfMap["replacement"].(string); {
+		case action == string(awstypes.ChangeActionRemove):
+			warnings = append(warnings, fmt.Sprintf("resource %s (%s) will be removed", tfMap["logical_resource_id"], tfMap[names.AttrResourceType]))
+		case replacement == string(awstypes.ReplacementTrue):
+			warnings = append(warnings, fmt.Sprintf("resource %s (%s) will be replaced", tfMap["logical_resource_id"], tfMap[names.AttrResourceType]))
+		case replacement == string(awstypes.ReplacementConditional):
+			warnings = append(warnings, fmt.Sprintf("resource %s (%s) may be replaced", tfMap["logical_resource_id"], tfMap[names.AttrResourceType]))
+		}
+	}
+
+	return warnings
+}
+
+func expandCreateChangeSetInput(d sdkv2.ResourceDiffer, changeSetName string, tags []awstypes.Tag) (*cloudformation.CreateChangeSetInput, error) {
+	input := &cloudformation.CreateChangeSetInput{
+		ChangeSetName: aws.String(changeSetName),
+		ChangeSetType: awstypes.ChangeSetTypeUpdate,
+		StackName:     aws.String(d.Id()),
+		Tags:          []awstypes.Tag{},
+	}
+
+	// Capabilities must be present whether they are changed or not
+	if v, ok := d.GetOk("capabilities"); ok {
+		input.Capabilities = flex.ExpandStringyValueSet[awstypes.Capability](v.(*schema.Set))
+	}
+	if d.HasChange(names.AttrIAMRoleARN) {
+		input.RoleARN = aws.String(d.Get(names.AttrIAMRoleARN).(string))
+	}
+	if d.HasChange("notification_arns") {
+		input.NotificationARNs = flex.ExpandStringValueSet(d.Get("notification_arns").(*schema.Set))
+	}
+	// Parameters must be present whether they are changed or not
+	if v, ok := d.GetOk(names.AttrParameters); ok {
+		input.Parameters = expandParameters(v.(map[string]any))
+	}
+	// Either TemplateBody, TemplateURL or UsePreviousTemplate are required
+	if v, ok := d.GetOk("template_url"); ok {
+		input.TemplateURL = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("template_body"); ok && input.TemplateURL == nil {
+		template, err := verify.NormalizeJSONOrYAMLString(v)
+		if err != nil {
+			return nil, err
+		}
+		input.TemplateBody = aws.String(template)
+	}
+
+	if len(tags) > 0 {
+		input.Tags = tags
+	}
+
+	return input, nil
+}
+
+// createChangeSetAndDescribeChanges creates a change set and returns its changes.
+// nil is returned if the change set contains no changes.
+func createChangeSetAndDescribeChanges(ctx context.Context, conn *cloudformation.Client, input *cloudformation.CreateChangeSetInput) ([]awstypes.Change, error) {
+	outputRaw, err := tfresource.RetryWhenAWSErrMessageContains(ctx, propagationTimeout, func() (any, error) {
+		return conn.CreateChangeSet(ctx, input)
+	}, errCodeValidationError, "is invalid or cannot be assumed")
+
+	if err != nil {
+		return nil, err
+	}
+
+	stackID, changeSetID := aws.ToString(outputRaw.(*cloudformation.CreateChangeSetOutput).StackId), aws.ToString(outputRaw.(*cloudformation.CreateChangeSetOutput).Id)
+	output, err := waitChangeSetCreated(ctx, conn, stackID, changeSetID)
+
+	if changeSetHasNoChanges(output) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return findChangeSetChangesByTwoPartKey(ctx, conn, stackID, changeSetID)
+}
+
+// changeSetDifferences returns descriptions of the resource changes in actual that are not in planned,
+// and of those in planned that are not in actual.
+func changeSetDifferences(planned, actual []any) []string {
+	key := func(tfMap map[string]any) string {
+		return fmt.Sprintf("%s %s %s (replacement: %s)", tfMap[names.AttrAction], tfMap["logical_resource_id"], tfMap[names.AttrResourceType], tfMap["replacement"])
+	}
+	keys := func(tfList []any) map[string]struct{} {
+		keys := make(map[string]struct{}, len(tfList))
+		for _, tfMapRaw := range tfList {
+			if tfMap, ok := tfMapRaw.(map[string]any); ok {
+				keys[key(tfMap)] = struct{}{}
+			}
+		}
+		return keys
+	}
+	plannedKeys, actualKeys := keys(planned), keys(actual)
+
+	var differences []string
+	for _, k := range slices.Sorted(maps.Keys(actualKeys)) {
+		if _, ok := plannedKeys[k]; !ok {
+			differences = append(differences, "not planned: "+k)
+		}
+	}
+	for _, k := range slices.Sorted(maps.Keys(plannedKeys)) {
+		if _, ok := actualKeys[k]; !ok {
+			differences = append(differences, "no longer planned: "+k)
+		}
+	}
+
+	return differences
+}
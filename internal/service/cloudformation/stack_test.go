@@ -10,8 +10,10 @@ import (
 
 	"github.com/YakDriver/regexache"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/google/go-cmp/cmp"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
 	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
@@ -572,6 +574,121 @@ func TestAccCloudFormationStack_templateUpdate(t *testing.T) {
 	})
 }
 
+func TestAccCloudFormationStack_previewChanges(t *testing.T) {
+	ctx := acctest.Context(t)
+	var stack awstypes.Stack
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudformation_stack.test"
+
+	vpcCidrInitial := "10.0.0.0/16"
+	vpcCidrUpdated := "12.0.0.0/16"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudFormationServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckStackDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStackConfig_previewChanges(rName, vpcCidrInitial),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckStackExists(ctx, resourceName, &stack),
+					resource.TestCheckResourceAttr(resourceName, "preview_changes", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "planned_change_warnings.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "planned_changes.#", "0"),
+				),
+			},
+			{
+				Config: testAccStackConfig_previewChanges(rName, vpcCidrUpdated),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+						plancheck.ExpectKnownValue(resourceName, tfjsonpath.New("planned_changes"), knownvalue.ListExact([]knownvalue.Check{
+							knownvalue.ObjectPartial(map[string]knownvalue.Check{
+								names.AttrAction:       knownvalue.StringExact(string(awstypes.ChangeActionModify)),
+								"logical_resource_id":  knownvalue.StringExact("MyVPC"),
+								"replacement":          knownvalue.StringExact(string(awstypes.ReplacementTrue)),
+								names.AttrResourceType: knownvalue.StringExact("AWS::EC2::VPC"),
+							}),
+						})),
+						plancheck.ExpectKnownValue(resourceName, tfjsonpath.New("planned_change_warnings"), knownvalue.ListExact([]knownvalue.Check{
+							knownvalue.StringExact("resource MyVPC (AWS::EC2::VPC) will be replaced"),
+						})),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckStackExists(ctx, resourceName, &stack),
+					resource.TestCheckResourceAttr(resourceName, "parameters.VpcCIDR", vpcCidrUpdated),
+					resource.TestCheckResourceAttr(resourceName, "planned_change_warnings.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "planned_changes.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "planned_changes.0.logical_resource_id", "MyVPC"),
+					resource.TestCheckResourceAttr(resourceName, "planned_changes.0.replacement", string(awstypes.ReplacementTrue)),
+				),
+			},
+			{
+				Config:   testAccStackConfig_previewChanges(rName, vpcCidrUpdated),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestChangeSetDifferences(t *testing.T) {
+	t.Parallel()
+
+	change := func(action, logicalResourceID, replacement string) map[string]any {
+		return map[string]any{
+			names.AttrAction:       action,
+			"logical_resource_id":  logicalResourceID,
+			"physical_resource_id": "",
+			"replacement":          replacement,
+			names.AttrResourceType: "AWS::EC2::VPC",
+		}
+	}
+
+	testCases := map[string]struct {
+		planned []any
+		actual  []any
+		want    []string
+	}{
+		"no changes": {},
+		"same": {
+			planned: []any{change("Modify", "MyVPC", "True")},
+			actual:  []any{change("Modify", "MyVPC", "True")},
+		},
+		"not planned": {
+			planned: []any{change("Modify", "MyVPC", "False")},
+			actual:  []any{change("Modify", "MyVPC", "False"), change("Add", "OtherVPC", "")},
+			want:    []string{"not planned: Add OtherVPC AWS::EC2::VPC (replacement: )"},
+		},
+		"no longer planned": {
+			planned: []any{change("Modify", "MyVPC", "False"), change("Remove", "OtherVPC", "")},
+			actual:  []any{change("Modify", "MyVPC", "False")},
+			want:    []string{"no longer planned: Remove OtherVPC AWS::EC2::VPC (replacement: )"},
+		},
+		"replacement changed": {
+			planned: []any{change("Modify", "MyVPC", "False")},
+			actual:  []any{change("Modify", "MyVPC", "True")},
+			want: []string{
+				"not planned: Modify MyVPC AWS::EC2::VPC (replacement: True)",
+				"no longer planned: Modify MyVPC AWS::EC2::VPC (replacement: False)",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tfcloudformation.ChangeSetDifferences(testCase.planned, testCase.actual)
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
 func testAccCheckStackExists(ctx context.Context, n string, v *awstypes.Stack) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -1210,3 +1327,38 @@ output "stack_output" {
 }
 `, rName, name, value)
 }
+
+func testAccStackConfig_previewChanges(rName, cidr string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudformation_stack" "test" {
+  name            = %[1]q
+  preview_changes = true
+
+  parameters = {
+    VpcCIDR = %[2]q
+  }
+
+  template_body = <<STACK
+{
+  "Parameters" : {
+    "VpcCIDR" : {
+      "Description" : "CIDR to be used for the VPC",
+      "Type" : "String"
+    }
+  },
+  "Resources" : {
+    "MyVPC": {
+      "Type" : "AWS::EC2::VPC",
+      "Properties" : {
+        "CidrBlock" : {"Ref": "VpcCIDR"},
+        "Tags" : [
+          {"Key": "Name", "Value": %[1]q}
+        ]
+      }
+    }
+  }
+}
+STACK
+}
+`, rName, cidr)
+}
@@ -0,0 +1,208 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudfront
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudfront"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudfront/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @Action("aws_cloudfront_create_invalidation", name="Create Invalidation")
+func newCreateInvalidationAction(context.Context) (action.ActionWithConfigure, error) {
+	return &createInvalidationAction{}, nil
+}
+
+type createInvalidationAction struct {
+	framework.ActionWithConfigure
+}
+
+func (a *createInvalidationAction) Schema(ctx context.Context, request action.SchemaRequest, response *action.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"caller_reference": schema.StringAttribute{
+				Optional: true,
+			},
+			"distribution_id": schema.StringAttribute{
+				Required: true,
+			},
+			"paths": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.Between(60, 3600),
+				},
+			},
+			"wait_for_completion": schema.BoolAttribute{
+				Optional: true,
+			},
+		},
+	}
+}
+
+func (a *createInvalidationAction) Invoke(ctx context.Context, request action.InvokeRequest, response *action.InvokeResponse) {
+	var data createInvalidationActionModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().CloudFrontClient(ctx)
+
+	distributionID := fwflex.StringValueFromFramework(ctx, data.DistributionID)
+	callerReference := id.UniqueId()
+	if !data.CallerReference.IsNull() {
+		callerReference = data.CallerReference.ValueString()
+	}
+	paths := fwflex.ExpandFrameworkStringValueList(ctx, data.Paths)
+	input := cloudfront.CreateInvalidationInput{
+		DistributionId: aws.String(distributionID),
+		InvalidationBatch: &awstypes.InvalidationBatch{
+			CallerReference: aws.String(callerReference),
+			Paths: &awstypes.Paths{
+				Items:    paths,
+				Quantity: aws.Int32(int32(len(paths))),
+			},
+		},
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Creating invalidation for CloudFront Distribution %s...", distributionID),
+	})
+
+	output, err := conn.CreateInvalidation(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating CloudFront Distribution (%s) invalidation", distributionID), err.Error())
+
+		return
+	}
+
+	invalidationID := aws.ToString(output.Invalidation.Id)
+
+	if !data.WaitForCompletion.IsNull() && !data.WaitForCompletion.ValueBool() {
+		response.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("CloudFront Distribution %s invalidation %s created", distributionID, invalidationID),
+		})
+
+		return
+	}
+
+	timeout := 15 * time.Minute
+	if !data.Timeout.IsNull() {
+		timeout = time.Duration(data.Timeout.ValueInt64()) * time.Second
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Waiting for CloudFront Distribution %s invalidation %s to complete...", distributionID, invalidationID),
+	})
+
+	if _, err := waitInvalidationCompleted(ctx, conn, distributionID, invalidationID, timeout); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for CloudFront Distribution (%s) invalidation (%s) complete", distributionID, invalidationID), err.Error())
+
+		return
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("CloudFront Distribution %s invalidation %s completed", distributionID, invalidationID),
+	})
+}
+
+type createInvalidationActionModel struct {
+	CallerReference   types.String         `tfsdk:"caller_reference"`
+	DistributionID    types.String         `tfsdk:"distribution_id"`
+	Paths             fwtypes.ListOfString `tfsdk:"paths"`
+	Timeout           types.Int64          `tfsdk:"timeout"`
+	WaitForCompletion types.Bool           `tfsdk:"wait_for_completion"`
+}
+
+const (
+	invalidationStatusCompleted  = "Completed"
+	invalidationStatusInProgress = "InProgress"
+)
+
+func findInvalidationByTwoPartKey(ctx context.Context, conn *cloudfront.Client, distributionID, invalidationID string) (*awstypes.Invalidation, error) {
+	input := cloudfront.GetInvalidationInput{
+		DistributionId: aws.String(distributionID),
+		Id:             aws.String(invalidationID),
+	}
+
+	output, err := conn.GetInvalidation(ctx, &input)
+
+	if errs.IsA[*awstypes.NoSuchInvalidation](err) || errs.IsA[*awstypes.NoSuchDistribution](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Invalidation == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Invalidation, nil
+}
+
+func statusInvalidation(ctx context.Context, conn *cloudfront.Client, distributionID, invalidationID string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, err := findInvalidationByTwoPartKey(ctx, conn, distributionID, invalidationID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.ToString(output.Status), nil
+	}
+}
+
+func waitInvalidationCompleted(ctx context.Context, conn *cloudfront.Client, distributionID, invalidationID string, timeout time.Duration) (*awstypes.Invalidation, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    []string{invalidationStatusInProgress},
+		Target:     []string{invalidationStatusCompleted},
+		Refresh:    statusInvalidation(ctx, conn, distributionID, invalidationID),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Invalidation); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,94 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudfront_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudfront"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudfront/types"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCloudFrontCreateInvalidationAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var distribution awstypes.Distribution
+	resourceName := "aws_cloudfront_distribution.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.CloudFrontEndpointID) },
+		ErrorCheck: acctest.ErrorCheck(t, names.CloudFrontServiceID),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.14.0"))),
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDistributionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCreateInvalidationActionConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDistributionExists(ctx, resourceName, &distribution),
+					testAccCheckDistributionHasInvalidations(ctx, resourceName),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDistributionHasInvalidations(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CloudFrontClient(ctx)
+
+		input := cloudfront.ListInvalidationsInput{
+			DistributionId: aws.String(rs.Primary.ID),
+		}
+		output, err := conn.ListInvalidations(ctx, &input)
+
+		if err != nil {
+			return err
+		}
+
+		if output.InvalidationList == nil || aws.ToInt32(output.InvalidationList.Quantity) == 0 {
+			return fmt.Errorf("CloudFront Distribution (%s) has no invalidations", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCreateInvalidationActionConfig_basic() string {
+	return acctest.ConfigCompose(testAccDistributionConfig_enabled(false, false), `
+action "aws_cloudfront_create_invalidation" "test" {
+  config {
+    distribution_id = aws_cloudfront_distribution.test.id
+    paths           = ["/*"]
+  }
+}
+
+resource "terraform_data" "test" {
+  input = aws_cloudfront_distribution.test.etag
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.aws_cloudfront_create_invalidation.test]
+    }
+  }
+}
+`)
+}
@@ -0,0 +1,191 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package codebuild
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/codebuild"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/codebuild/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @Action("aws_codebuild_start_build", name="Start Build")
+func newStartBuildAction(context.Context) (action.ActionWithConfigure, error) {
+	return &startBuildAction{}, nil
+}
+
+type startBuildAction struct {
+	framework.ActionWithConfigure
+}
+
+func (a *startBuildAction) Schema(ctx context.Context, request action.SchemaRequest, response *action.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"environment_variables": schema.MapAttribute{
+				CustomType:  fwtypes.MapOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"project_name": schema.StringAttribute{
+				Required: true,
+			},
+			"source_version": schema.StringAttribute{
+				Optional: true,
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.Between(60, 28800),
+				},
+			},
+			"wait_for_completion": schema.BoolAttribute{
+				Optional: true,
+			},
+		},
+	}
+}
+
+func (a *startBuildAction) Invoke(ctx context.Context, request action.InvokeRequest, response *action.InvokeResponse) {
+	var data startBuildActionModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().CodeBuildClient(ctx)
+
+	projectName := fwflex.StringValueFromFramework(ctx, data.ProjectName)
+	input := codebuild.StartBuildInput{
+		ProjectName:   aws.String(projectName),
+		SourceVersion: fwflex.StringFromFramework(ctx, data.SourceVersion),
+	}
+	for k, v := range fwflex.ExpandFrameworkStringValueMap(ctx, data.EnvironmentVariables) {
+		input.EnvironmentVariablesOverride = append(input.EnvironmentVariablesOverride, awstypes.EnvironmentVariable{
+			Name:  aws.String(k),
+			Type:  awstypes.EnvironmentVariableTypePlaintext,
+			Value: aws.String(v),
+		})
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Starting CodeBuild Project %s build...", projectName),
+	})
+
+	output, err := conn.StartBuild(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("starting CodeBuild Project (%s) build", projectName), err.Error())
+
+		return
+	}
+
+	buildID := aws.ToString(output.Build.Id)
+
+	if !data.WaitForCompletion.IsNull() && !data.WaitForCompletion.ValueBool() {
+		response.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("CodeBuild build %s started", buildID),
+		})
+
+		return
+	}
+
+	timeout := 30 * time.Minute
+	if !data.Timeout.IsNull() {
+		timeout = time.Duration(data.Timeout.ValueInt64()) * time.Second
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Waiting for CodeBuild build %s to complete...", buildID),
+	})
+
+	if _, err := waitBuildSucceeded(ctx, conn, buildID, timeout); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for CodeBuild build (%s) complete", buildID), err.Error())
+
+		return
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("CodeBuild build %s succeeded", buildID),
+	})
+}
+
+type startBuildActionModel struct {
+	EnvironmentVariables fwtypes.MapOfString `tfsdk:"environment_variables"`
+	ProjectName          types.String        `tfsdk:"project_name"`
+	SourceVersion        types.String        `tfsdk:"source_version"`
+	Timeout              types.Int64         `tfsdk:"timeout"`
+	WaitForCompletion    types.Bool          `tfsdk:"wait_for_completion"`
+}
+
+func findBuildByID(ctx context.Context, conn *codebuild.Client, id string) (*awstypes.Build, error) {
+	input := codebuild.BatchGetBuildsInput{
+		Ids: []string{id},
+	}
+
+	output, err := conn.BatchGetBuilds(ctx, &input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return tfresource.AssertSingleValueResult(output.Builds)
+}
+
+func statusBuild(ctx context.Context, conn *codebuild.Client, id string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, err := findBuildByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.BuildStatus), nil
+	}
+}
+
+func waitBuildSucceeded(ctx context.Context, conn *codebuild.Client, id string, timeout time.Duration) (*awstypes.Build, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.StatusTypeInProgress),
+		Target:     enum.Slice(awstypes.StatusTypeSucceeded),
+		Refresh:    statusBuild(ctx, conn, id),
+		Timeout:    timeout,
+		MinTimeout: 15 * time.Second,
+		Delay:      15 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.Build); ok {
+		if phase := output.CurrentPhase; phase != nil {
+			tfresource.SetLastError(err, fmt.Errorf("build status %s in phase %s", output.BuildStatus, aws.ToString(phase)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
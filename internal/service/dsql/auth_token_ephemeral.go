@@ -0,0 +1,141 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dsql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	ERNameAuthToken = "Ephemeral Resource Auth Token"
+)
+
+const (
+	authTokenDefaultExpiresIn = 15 * time.Minute
+	authTokenMaxExpiresIn     = 7 * 24 * time.Hour
+)
+
+// @EphemeralResource(aws_dsql_auth_token, name="AuthToken")
+func newEphemeralAuthToken(_ context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &ephemeralAuthToken{}, nil
+}
+
+type ephemeralAuthToken struct {
+	framework.EphemeralResourceWithConfigure
+}
+
+func (e *ephemeralAuthToken) Schema(ctx context.Context, _ ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"admin": schema.BoolAttribute{
+				Optional: true,
+			},
+			"expires_in": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, int64(authTokenMaxExpiresIn.Seconds())),
+				},
+			},
+			"hostname": schema.StringAttribute{
+				Required: true,
+			},
+			"token": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func (e *ephemeralAuthToken) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data epAuthTokenData
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	expiresIn := authTokenDefaultExpiresIn
+	if !data.ExpiresIn.IsNull() {
+		expiresIn = time.Duration(data.ExpiresIn.ValueInt64()) * time.Second
+	}
+
+	hostname := data.Hostname.ValueString()
+	token, err := generateAuthToken(ctx, e.Meta().CredentialsProvider(ctx), hostname, e.Meta().Region(ctx), data.Admin.ValueBool(), expiresIn, time.Now())
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.DSQL, create.ErrActionReading, ERNameAuthToken, hostname, err),
+			err.Error(),
+		)
+		return
+	}
+
+	data.Token = types.StringValue(token)
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+}
+
+// generateAuthToken returns an IAM authentication token used as the password when connecting to the DSQL cluster at the specified hostname.
+// See https://docs.aws.amazon.com/aurora-dsql/latest/userguide/SECTION_authentication-token.html.
+func generateAuthToken(ctx context.Context, credentialsProvider aws.CredentialsProvider, hostname, region string, admin bool, expiresIn time.Duration, signingTime time.Time) (string, error) {
+	if credentialsProvider == nil {
+		return "", errors.New("no AWS credentials")
+	}
+
+	credentials, err := credentialsProvider.Retrieve(ctx)
+	if err != nil {
+		return "", fmt.Errorf("retrieving AWS credentials: %w", err)
+	}
+
+	action := "DbConnect"
+	if admin {
+		action = "DbConnectAdmin"
+	}
+
+	values := url.Values{
+		"Action":        []string{action},
+		"X-Amz-Expires": []string{strconv.FormatInt(int64(expiresIn.Seconds()), 10)},
+	}
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://"+hostname+"/?"+values.Encode(), nil)
+	if err != nil {
+		return "", err
+	}
+
+	const (
+		emptyPayloadHash = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855" // SHA-256 hash of an empty payload.
+		signingName      = "dsql"
+	)
+	signedURI, _, err := v4.NewSigner().PresignHTTP(ctx, credentials, request, emptyPayloadHash, signingName, region, signingTime)
+	if err != nil {
+		return "", fmt.Errorf("presigning request: %w", err)
+	}
+
+	// The token is the presigned URL without the scheme.
+	return strings.TrimPrefix(signedURI, "https://"), nil
+}
+
+type epAuthTokenData struct {
+	Admin     types.Bool   `tfsdk:"admin"`
+	ExpiresIn types.Int64  `tfsdk:"expires_in"`
+	Hostname  types.String `tfsdk:"hostname"`
+	Token     types.String `tfsdk:"token"`
+}
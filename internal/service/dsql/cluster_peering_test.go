@@ -0,0 +1,85 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dsql_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/dsql"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccDSQLClusterPeering_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var cluster dsql.GetClusterOutput
+	resourceName := "aws_dsql_cluster_peering.test1"
+	clusterResourceName := "aws_dsql_cluster.test1"
+	peerClusterResourceName := "aws_dsql_cluster.test2"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckMultipleRegion(t, 3)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.DSQLServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesMultipleRegions(ctx, t, 3),
+		CheckDestroy:             testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterPeeringConfig_basic(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckClusterExists(ctx, clusterResourceName, &cluster),
+					resource.TestCheckResourceAttr(resourceName, "clusters.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "clusters.*", peerClusterResourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrIdentifier, clusterResourceName, names.AttrIdentifier),
+					resource.TestCheckResourceAttr(resourceName, "witness_region", acctest.ThirdRegion()),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, names.AttrIdentifier),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: names.AttrIdentifier,
+				ImportStateVerifyIgnore:              []string{names.AttrTimeouts},
+			},
+		},
+	})
+}
+
+func testAccClusterPeeringConfig_basic() string {
+	return acctest.ConfigCompose(acctest.ConfigMultipleRegionProvider(3), fmt.Sprintf(`
+resource "aws_dsql_cluster" "test1" {
+  multi_region_properties {
+    witness_region = %[1]q
+  }
+}
+
+resource "aws_dsql_cluster" "test2" {
+  provider = "awsalternate"
+
+  multi_region_properties {
+    witness_region = %[1]q
+  }
+}
+
+resource "aws_dsql_cluster_peering" "test1" {
+  identifier     = aws_dsql_cluster.test1.identifier
+  clusters       = [aws_dsql_cluster.test2.arn]
+  witness_region = aws_dsql_cluster.test1.multi_region_properties[0].witness_region
+}
+
+resource "aws_dsql_cluster_peering" "test2" {
+  provider = "awsalternate"
+
+  identifier     = aws_dsql_cluster.test2.identifier
+  clusters       = [aws_dsql_cluster.test1.arn]
+  witness_region = aws_dsql_cluster.test2.multi_region_properties[0].witness_region
+}
+`, acctest.ThirdRegion()))
+}
@@ -0,0 +1,131 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @Action("aws_ec2_stop_instance", name="Stop Instance")
+func newStopInstanceAction(context.Context) (action.ActionWithConfigure, error) {
+	return &stopInstanceAction{}, nil
+}
+
+type stopInstanceAction struct {
+	framework.ActionWithConfigure
+}
+
+func (a *stopInstanceAction) Schema(ctx context.Context, request action.SchemaRequest, response *action.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"force": schema.BoolAttribute{
+				Optional: true,
+			},
+			names.AttrInstanceID: schema.StringAttribute{
+				Required: true,
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.Between(30, 3600),
+				},
+			},
+			"wait_for_completion": schema.BoolAttribute{
+				Optional: true,
+			},
+		},
+	}
+}
+
+func (a *stopInstanceAction) Invoke(ctx context.Context, request action.InvokeRequest, response *action.InvokeResponse) {
+	var data stopInstanceActionModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().EC2Client(ctx)
+
+	instanceID := fwflex.StringValueFromFramework(ctx, data.InstanceID)
+	instance, err := findInstanceByID(ctx, conn, instanceID)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading EC2 Instance (%s)", instanceID), err.Error())
+
+		return
+	}
+
+	switch state := instance.State.Name; state {
+	case awstypes.InstanceStateNameStopped:
+		response.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("EC2 Instance %s is already stopped", instanceID),
+		})
+
+		return
+	case awstypes.InstanceStateNamePending, awstypes.InstanceStateNameRunning, awstypes.InstanceStateNameStopping:
+	default:
+		response.Diagnostics.AddError(fmt.Sprintf("stopping EC2 Instance (%s)", instanceID), fmt.Sprintf("instance is in state %q and cannot be stopped", state))
+
+		return
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Stopping EC2 Instance %s...", instanceID),
+	})
+
+	input := ec2.StopInstancesInput{
+		Force:       fwflex.BoolFromFramework(ctx, data.Force),
+		InstanceIds: []string{instanceID},
+	}
+	_, err = conn.StopInstances(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("stopping EC2 Instance (%s)", instanceID), err.Error())
+
+		return
+	}
+
+	if !data.WaitForCompletion.IsNull() && !data.WaitForCompletion.ValueBool() {
+		return
+	}
+
+	timeout := 10 * time.Minute
+	if !data.Timeout.IsNull() {
+		timeout = time.Duration(data.Timeout.ValueInt64()) * time.Second
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Waiting for EC2 Instance %s to stop...", instanceID),
+	})
+
+	if _, err := waitInstanceStopped(ctx, conn, instanceID, timeout); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for EC2 Instance (%s) stop", instanceID), err.Error())
+
+		return
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("EC2 Instance %s stopped", instanceID),
+	})
+}
+
+type stopInstanceActionModel struct {
+	Force             types.Bool   `tfsdk:"force"`
+	InstanceID        types.String `tfsdk:"instance_id"`
+	Timeout           types.Int64  `tfsdk:"timeout"`
+	WaitForCompletion types.Bool   `tfsdk:"wait_for_completion"`
+}
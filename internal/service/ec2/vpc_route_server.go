@@ -0,0 +1,299 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_vpc_route_server", name="VPC Route Server")
+// @Tags(identifierAttribute="id")
+// @Testing(tagsTest=false)
+func newVPCRouteServerResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &vpcRouteServerResource{}
+
+	r.SetDefaultCreateTimeout(10 * time.Minute)
+	r.SetDefaultUpdateTimeout(10 * time.Minute)
+	r.SetDefaultDeleteTimeout(20 * time.Minute)
+
+	return r, nil
+}
+
+type vpcRouteServerResource struct {
+	framework.ResourceWithConfigure
+	framework.WithTimeouts
+	framework.WithImportByID
+}
+
+func (r *vpcRouteServerResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"amazon_side_asn": schema.Int64Attribute{
+				Required: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{
+					int64validator.Between(1, 4294967294),
+				},
+			},
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrID:  framework.IDAttribute(),
+			"persist_routes": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.RouteServerPersistRoutesAction](),
+				Optional:   true,
+				Computed:   true,
+				Default:    stringdefault.StaticString(string(awstypes.RouteServerPersistRoutesActionDisable)),
+			},
+			"persist_routes_duration": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64validator.Between(1, 5),
+				},
+			},
+			"sns_notifications_enabled": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"sns_topic_arn": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *vpcRouteServerResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data vpcRouteServerResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	input := &ec2.CreateRouteServerInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ClientToken = aws.String(id.UniqueId())
+	input.TagSpecifications = getTagSpecificationsIn(ctx, awstypes.ResourceTypeRouteServer)
+
+	output, err := conn.CreateRouteServer(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError("creating VPC Route Server", err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	routeServerID := aws.ToString(output.RouteServer.RouteServerId)
+	data.RouteServerID = fwflex.StringValueToFramework(ctx, routeServerID)
+	data.ARN = fwflex.StringValueToFramework(ctx, r.routeServerARN(ctx, routeServerID))
+
+	routeServer, err := waitRouteServerCreated(ctx, conn, routeServerID, r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), routeServerID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for VPC Route Server (%s) create", routeServerID), err.Error())
+
+		return
+	}
+
+	data.PersistRoutesDuration = fwflex.Int64ToFramework(ctx, routeServer.PersistRoutesDuration)
+	data.SNSTopicARN = fwflex.StringToFramework(ctx, routeServer.SnsTopicArn)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *vpcRouteServerResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data vpcRouteServerResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	routeServerID := data.RouteServerID.ValueString()
+	output, err := findRouteServerByID(ctx, conn, routeServerID)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading VPC Route Server (%s)", routeServerID), err.Error())
+
+		return
+	}
+
+	// Set attributes for import.
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.ARN = fwflex.StringValueToFramework(ctx, r.routeServerARN(ctx, routeServerID))
+	switch output.PersistRoutesState {
+	case awstypes.RouteServerPersistRoutesStateEnabled, awstypes.RouteServerPersistRoutesStateEnabling:
+		data.PersistRoutes = fwtypes.StringEnumValue(awstypes.RouteServerPersistRoutesActionEnable)
+	case awstypes.RouteServerPersistRoutesStateDisabled, awstypes.RouteServerPersistRoutesStateDisabling:
+		data.PersistRoutes = fwtypes.StringEnumValue(awstypes.RouteServerPersistRoutesActionDisable)
+	}
+
+	setTagsOut(ctx, output.Tags)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *vpcRouteServerResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new, old vpcRouteServerResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	routeServerID := new.RouteServerID.ValueString()
+
+	if !new.PersistRoutes.Equal(old.PersistRoutes) ||
+		!new.PersistRoutesDuration.Equal(old.PersistRoutesDuration) ||
+		!new.SNSNotificationsEnabled.Equal(old.SNSNotificationsEnabled) {
+		input := &ec2.ModifyRouteServerInput{
+			PersistRoutes:           new.PersistRoutes.ValueEnum(),
+			RouteServerId:           aws.String(routeServerID),
+			SnsNotificationsEnabled: fwflex.BoolFromFramework(ctx, new.SNSNotificationsEnabled),
+		}
+
+		if new.PersistRoutes.ValueEnum() == awstypes.RouteServerPersistRoutesActionEnable {
+			input.PersistRoutesDuration = fwflex.Int64FromFramework(ctx, new.PersistRoutesDuration)
+		}
+
+		_, err := conn.ModifyRouteServer(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating VPC Route Server (%s)", routeServerID), err.Error())
+
+			return
+		}
+
+		routeServer, err := waitRouteServerUpdated(ctx, conn, routeServerID, r.UpdateTimeout(ctx, new.Timeouts))
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for VPC Route Server (%s) update", routeServerID), err.Error())
+
+			return
+		}
+
+		new.PersistRoutesDuration = fwflex.Int64ToFramework(ctx, routeServer.PersistRoutesDuration)
+		new.SNSTopicARN = fwflex.StringToFramework(ctx, routeServer.SnsTopicArn)
+	} else {
+		new.PersistRoutesDuration = old.PersistRoutesDuration
+		new.SNSTopicARN = old.SNSTopicARN
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *vpcRouteServerResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data vpcRouteServerResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Client(ctx)
+
+	routeServerID := data.RouteServerID.ValueString()
+	input := &ec2.DeleteRouteServerInput{
+		RouteServerId: aws.String(routeServerID),
+	}
+
+	_, err := conn.DeleteRouteServer(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidRouteServerIdNotFound) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting VPC Route Server (%s)", routeServerID), err.Error())
+
+		return
+	}
+
+	if _, err := waitRouteServerDeleted(ctx, conn, routeServerID, r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for VPC Route Server (%s) delete", routeServerID), err.Error())
+
+		return
+	}
+}
+
+func (r *vpcRouteServerResource) routeServerARN(ctx context.Context, id string) string {
+	return r.Meta().RegionalARN(ctx, names.EC2, "route-server/"+id)
+}
+
+type vpcRouteServerResourceModel struct {
+	AmazonSideASN           types.Int64                                                 `tfsdk:"amazon_side_asn"`
+	ARN                     types.String                                                `tfsdk:"arn"`
+	PersistRoutes           fwtypes.StringEnum[awstypes.RouteServerPersistRoutesAction] `tfsdk:"persist_routes"`
+	PersistRoutesDuration   types.Int64                                                 `tfsdk:"persist_routes_duration"`
+	RouteServerID           types.String                                                `tfsdk:"id"`
+	SNSNotificationsEnabled types.Bool                                                  `tfsdk:"sns_notifications_enabled"`
+	SNSTopicARN             types.String                                                `tfsdk:"sns_topic_arn"`
+	Tags                    tftags.Map                                                  `tfsdk:"tags"`
+	TagsAll                 tftags.Map                                                  `tfsdk:"tags_all"`
+	Timeouts                timeouts.Value                                              `tfsdk:"timeouts"`
+}
@@ -0,0 +1,182 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccVPCRouteServerPeer_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_vpc_route_server_peer.test"
+	endpointResourceName := "aws_vpc_route_server_endpoint.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVPCRouteServerPeerDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCRouteServerPeerConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckVPCRouteServerPeerExists(ctx, resourceName),
+					acctest.MatchResourceAttrRegionalARN(ctx, resourceName, names.AttrARN, "ec2", regexache.MustCompile(`route-server-peer/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "bgp_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "bgp_options.0.peer_asn", "65001"),
+					resource.TestCheckResourceAttr(resourceName, "bgp_options.0.peer_liveness_detection", "bgp-keepalive"),
+					resource.TestCheckResourceAttrPair(resourceName, "endpoint_eni_address", endpointResourceName, "eni_address"),
+					resource.TestCheckResourceAttrPair(resourceName, "endpoint_eni_id", endpointResourceName, "eni_id"),
+					resource.TestCheckResourceAttr(resourceName, "peer_address", "10.0.0.10"),
+					resource.TestCheckResourceAttrPair(resourceName, "route_server_endpoint_id", endpointResourceName, names.AttrID),
+					resource.TestCheckResourceAttrPair(resourceName, "route_server_id", endpointResourceName, "route_server_id"),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrSubnetID, endpointResourceName, names.AttrSubnetID),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrVPCID, endpointResourceName, names.AttrVPCID),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"bfd_status", "bgp_status"},
+			},
+		},
+	})
+}
+
+func TestAccVPCRouteServerPeer_bfd(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_vpc_route_server_peer.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVPCRouteServerPeerDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCRouteServerPeerConfig_bfd(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckVPCRouteServerPeerExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "bgp_options.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "bgp_options.0.peer_asn", "65001"),
+					resource.TestCheckResourceAttr(resourceName, "bgp_options.0.peer_liveness_detection", "bfd"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVPCRouteServerPeer_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_vpc_route_server_peer.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVPCRouteServerPeerDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCRouteServerPeerConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCRouteServerPeerExists(ctx, resourceName),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfec2.ResourceVPCRouteServerPeer, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckVPCRouteServerPeerExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		_, err := tfec2.FindRouteServerPeerByID(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckVPCRouteServerPeerDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_vpc_route_server_peer" {
+				continue
+			}
+
+			_, err := tfec2.FindRouteServerPeerByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("VPC Route Server Peer %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccVPCRouteServerPeerConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccVPCRouteServerEndpointConfig_basic(rName), fmt.Sprintf(`
+resource "aws_vpc_route_server_peer" "test" {
+  route_server_endpoint_id = aws_vpc_route_server_endpoint.test.id
+  peer_address             = "10.0.0.10"
+
+  bgp_options {
+    peer_asn = 65001
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName))
+}
+
+func testAccVPCRouteServerPeerConfig_bfd(rName string) string {
+	return acctest.ConfigCompose(testAccVPCRouteServerEndpointConfig_basic(rName), fmt.Sprintf(`
+resource "aws_vpc_route_server_peer" "test" {
+  route_server_endpoint_id = aws_vpc_route_server_endpoint.test.id
+  peer_address             = "10.0.0.10"
+
+  bgp_options {
+    peer_asn                = 65001
+    peer_liveness_detection = "bfd"
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName))
+}
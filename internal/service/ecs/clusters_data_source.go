@@ -5,14 +5,20 @@ package ecs
 
 import (
 	"context"
+	"slices"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ecs"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ecs/types"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/namevaluesfilters"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 // @FrameworkDataSource("aws_ecs_clusters", name="Clusters")
@@ -20,6 +26,10 @@ func newClustersDataSource(context.Context) (datasource.DataSourceWithConfigure,
 	return &clustersDataSource{}, nil
 }
 
+const (
+	describeClustersMaxBatchSize = 100
+)
+
 type clustersDataSource struct {
 	framework.DataSourceWithConfigure
 }
@@ -33,6 +43,9 @@ func (d *clustersDataSource) Schema(ctx context.Context, request datasource.Sche
 				ElementType: types.StringType,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			names.AttrFilter: namevaluesfilters.FiltersBlock(ctx),
+		},
 	}
 }
 
@@ -53,6 +66,43 @@ func (d *clustersDataSource) Read(ctx context.Context, request datasource.ReadRe
 		return
 	}
 
+	filters, diags := namevaluesfilters.NewFromFramework(ctx, data.Filters)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// ListClusters does not support filtering, so describe the clusters and filter client-side.
+	if len(filters.Map()) > 0 {
+		var clusters []awstypes.Cluster
+
+		for chunk := range slices.Chunk(arns, describeClustersMaxBatchSize) {
+			input := ecs.DescribeClustersInput{
+				Clusters: chunk,
+				Include:  []awstypes.ClusterField{awstypes.ClusterFieldTags, awstypes.ClusterFieldConfigurations, awstypes.ClusterFieldSettings},
+			}
+			output, err := findClusters(ctx, conn, &input)
+
+			if err != nil {
+				response.Diagnostics.AddError("describing ECS Clusters", err.Error())
+				return
+			}
+
+			clusters = append(clusters, output...)
+		}
+
+		clusters, err = namevaluesfilters.Filter(filters, clusters)
+
+		if err != nil {
+			response.Diagnostics.AddError("filtering ECS Clusters", err.Error())
+			return
+		}
+
+		arns = tfslices.ApplyToAll(clusters, func(v awstypes.Cluster) string {
+			return aws.ToString(v.ClusterArn)
+		})
+	}
+
 	data.ClusterARNs = fwflex.FlattenFrameworkStringValueListOfString(ctx, arns)
 
 	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
@@ -76,5 +126,6 @@ func listClusters(ctx context.Context, conn *ecs.Client, input *ecs.ListClusters
 }
 
 type dataSourceClustersModel struct {
-	ClusterARNs fwtypes.ListOfString `tfsdk:"cluster_arns"`
+	ClusterARNs fwtypes.ListOfString      `tfsdk:"cluster_arns"`
+	Filters     namevaluesfilters.Filters `tfsdk:"filter"`
 }
@@ -36,6 +36,32 @@ func TestAccECSClustersDataSource_basic(t *testing.T) {
 	})
 }
 
+func TestAccECSClustersDataSource_filter(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceResourceName := "data.aws_ecs_clusters.test"
+	resourceName := "aws_ecs_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.ECSEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClustersDataSourceConfig_filter(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceResourceName, "cluster_arns.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceResourceName, "cluster_arns.*", resourceName, names.AttrARN),
+				),
+			},
+		},
+	})
+}
+
 func testAccClustersDataSourceConfig_basic(rName string) string {
 	return acctest.ConfigCompose(testAccClusterConfig_basic(rName), `
 data "aws_ecs_clusters" "test" {
@@ -43,3 +69,14 @@ data "aws_ecs_clusters" "test" {
 }
 `)
 }
+
+func testAccClustersDataSourceConfig_filter(rName string) string {
+	return acctest.ConfigCompose(testAccClusterConfig_basic(rName), `
+data "aws_ecs_clusters" "test" {
+  filter {
+    name   = "ClusterName"
+    values = [aws_ecs_cluster.test.name]
+  }
+}
+`)
+}
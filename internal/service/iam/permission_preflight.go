@@ -0,0 +1,64 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+)
+
+// SimulatePrincipalActions returns the decision for each of the specified actions
+// when performed by the principal making API requests with the specified caller ARN.
+// The actions are simulated against all resources.
+func SimulatePrincipalActions(ctx context.Context, conn *iam.Client, callerARN string, actions []string) (map[string]awstypes.PolicyEvaluationDecisionType, error) {
+	policySourceARN, err := principalARNForCaller(ctx, conn, callerARN)
+	if err != nil {
+		return nil, err
+	}
+
+	decisions := make(map[string]awstypes.PolicyEvaluationDecisionType, len(actions))
+
+	const (
+		maxActionNames = 100
+	)
+	for chunk := range slices.Chunk(actions, maxActionNames) {
+		input := &iam.SimulatePrincipalPolicyInput{
+			ActionNames:     chunk,
+			MaxItems:        aws.Int32(1000),
+			PolicySourceArn: aws.String(policySourceARN),
+		}
+
+		results, err := simulatePrincipalPolicy(ctx, conn, input)
+		if err != nil {
+			return nil, fmt.Errorf("simulating IAM Principal Policy (%s): %w", policySourceARN, err)
+		}
+
+		for _, result := range results {
+			decisions[aws.ToString(result.EvalActionName)] = result.EvalDecision
+		}
+	}
+
+	return decisions, nil
+}
+
+// principalARNForCaller returns the ARN of the IAM principal whose policies apply to the caller.
+// Policies cannot be simulated for STS assumed-role session ARNs, so the session's role ARN is used instead.
+func principalARNForCaller(ctx context.Context, conn *iam.Client, callerARN string) (string, error) {
+	roleName, _ := RoleNameSessionFromARN(callerARN)
+	if roleName == "" {
+		return callerARN, nil
+	}
+
+	role, err := findRoleByName(ctx, conn, roleName)
+	if err != nil {
+		return "", fmt.Errorf("reading IAM Role (%s): %w", roleName, err)
+	}
+
+	return aws.ToString(role.Arn), nil
+}
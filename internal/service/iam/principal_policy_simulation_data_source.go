@@ -257,23 +257,9 @@ func dataSourcePrincipalPolicySimulationRead(ctx context.Context, d *schema.Reso
 	// round-trips.
 	input.MaxItems = aws.Int32(1000)
 
-	var results []awstypes.EvaluationResult
-
-	for { // Terminates below, once we see a result that does not set IsTruncated.
-		output, err := conn.SimulatePrincipalPolicy(ctx, input)
-		if err != nil {
-			return sdkdiag.AppendErrorf(diags, "simulating IAM Principal Policy: %s", err)
-		}
-
-		results = append(results, output.EvaluationResults...)
-
-		if !output.IsTruncated {
-			break // All done!
-		}
-
-		// If we're making another request then we need to specify the marker
-		// to get the next page of results.
-		input.Marker = output.Marker
+	results, err := simulatePrincipalPolicy(ctx, conn, input)
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "simulating IAM Principal Policy: %s", err)
 	}
 
 	// While we build the result we'll also tally up the number of allowed
@@ -337,3 +323,26 @@ func dataSourcePrincipalPolicySimulationRead(ctx context.Context, d *schema.Reso
 
 	return diags
 }
+
+func simulatePrincipalPolicy(ctx context.Context, conn *iam.Client, input *iam.SimulatePrincipalPolicyInput) ([]awstypes.EvaluationResult, error) {
+	var results []awstypes.EvaluationResult
+
+	for { // Terminates below, once we see a result that does not set IsTruncated.
+		output, err := conn.SimulatePrincipalPolicy(ctx, input)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, output.EvaluationResults...)
+
+		if !output.IsTruncated {
+			break // All done!
+		}
+
+		// If we're making another request then we need to specify the marker
+		// to get the next page of results.
+		input.Marker = output.Marker
+	}
+
+	return results, nil
+}
@@ -0,0 +1,333 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package invoicing
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/invoicing"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/invoicing/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_invoicing_invoice_unit", name="Invoice Unit")
+// @Tags(identifierAttribute="arn")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/invoicing;invoicing.GetInvoiceUnitOutput")
+func newInvoiceUnitResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &invoiceUnitResource{}
+
+	return r, nil
+}
+
+type invoiceUnitResource struct {
+	framework.ResourceWithConfigure
+}
+
+func (r *invoiceUnitResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(500),
+				},
+			},
+			"invoice_receiver": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					fwvalidators.AWSAccountID(),
+				},
+			},
+			"last_modified": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 50),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"tax_inheritance_disabled": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrRule: schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[invoiceUnitRuleModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"linked_accounts": schema.SetAttribute{
+							CustomType:  fwtypes.SetOfStringType,
+							ElementType: types.StringType,
+							Required:    true,
+							Validators: []validator.Set{
+								setvalidator.SizeAtLeast(1),
+								setvalidator.ValueStringsAre(fwvalidators.AWSAccountID()),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *invoiceUnitResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data invoiceUnitResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().InvoicingClient(ctx)
+
+	name := data.Name.ValueString()
+	var input invoicing.CreateInvoiceUnitInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ResourceTags = getTagsIn(ctx)
+
+	output, err := conn.CreateInvoiceUnit(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Invoicing Invoice Unit (%s)", name), err.Error())
+
+		return
+	}
+
+	arn := aws.ToString(output.InvoiceUnitArn)
+	invoiceUnit, err := findInvoiceUnitByARN(ctx, conn, arn)
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrARN), arn) // Set 'arn' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("reading Invoicing Invoice Unit (%s)", arn), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.InvoiceUnitARN = fwflex.StringValueToFramework(ctx, arn)
+	data.LastModified = timetypes.NewRFC3339TimePointerValue(invoiceUnit.LastModified)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *invoiceUnitResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data invoiceUnitResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().InvoicingClient(ctx)
+
+	arn := data.InvoiceUnitARN.ValueString()
+	output, err := findInvoiceUnitByARN(ctx, conn, arn)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Invoicing Invoice Unit (%s)", arn), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *invoiceUnitResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new, old invoiceUnitResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().InvoicingClient(ctx)
+
+	arn := new.InvoiceUnitARN.ValueString()
+
+	if !new.Description.Equal(old.Description) || !new.Rule.Equal(old.Rule) || !new.TaxInheritanceDisabled.Equal(old.TaxInheritanceDisabled) {
+		var input invoicing.UpdateInvoiceUnitInput
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, &input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// An empty description clears the existing value.
+		if new.Description.IsNull() {
+			input.Description = aws.String("")
+		}
+
+		_, err := conn.UpdateInvoiceUnit(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Invoicing Invoice Unit (%s)", arn), err.Error())
+
+			return
+		}
+	}
+
+	invoiceUnit, err := findInvoiceUnitByARN(ctx, conn, arn)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Invoicing Invoice Unit (%s)", arn), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	new.LastModified = timetypes.NewRFC3339TimePointerValue(invoiceUnit.LastModified)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *invoiceUnitResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data invoiceUnitResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().InvoicingClient(ctx)
+
+	arn := data.InvoiceUnitARN.ValueString()
+	input := invoicing.DeleteInvoiceUnitInput{
+		InvoiceUnitArn: aws.String(arn),
+	}
+	_, err := conn.DeleteInvoiceUnit(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Invoicing Invoice Unit (%s)", arn), err.Error())
+
+		return
+	}
+}
+
+func (r *invoiceUnitResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrARN), request, response)
+}
+
+func findInvoiceUnitByARN(ctx context.Context, conn *invoicing.Client, arn string) (*invoicing.GetInvoiceUnitOutput, error) {
+	input := invoicing.GetInvoiceUnitInput{
+		InvoiceUnitArn: aws.String(arn),
+	}
+
+	return findInvoiceUnit(ctx, conn, &input)
+}
+
+func findInvoiceUnit(ctx context.Context, conn *invoicing.Client, input *invoicing.GetInvoiceUnitInput) (*invoicing.GetInvoiceUnitOutput, error) {
+	output, err := conn.GetInvoiceUnit(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func findInvoiceUnits(ctx context.Context, conn *invoicing.Client, input *invoicing.ListInvoiceUnitsInput) ([]awstypes.InvoiceUnit, error) {
+	var output []awstypes.InvoiceUnit
+
+	pages := invoicing.NewListInvoiceUnitsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.InvoiceUnits...)
+	}
+
+	return output, nil
+}
+
+type invoiceUnitResourceModel struct {
+	Description            types.String                                          `tfsdk:"description"`
+	InvoiceReceiver        types.String                                          `tfsdk:"invoice_receiver"`
+	InvoiceUnitARN         types.String                                          `tfsdk:"arn"`
+	LastModified           timetypes.RFC3339                                     `tfsdk:"last_modified"`
+	Name                   types.String                                          `tfsdk:"name"`
+	Rule                   fwtypes.ListNestedObjectValueOf[invoiceUnitRuleModel] `tfsdk:"rule"`
+	Tags                   tftags.Map                                            `tfsdk:"tags"`
+	TagsAll                tftags.Map                                            `tfsdk:"tags_all"`
+	TaxInheritanceDisabled types.Bool                                            `tfsdk:"tax_inheritance_disabled"`
+}
+
+type invoiceUnitRuleModel struct {
+	LinkedAccounts fwtypes.SetOfString `tfsdk:"linked_accounts"`
+}
@@ -0,0 +1,55 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package invoicing_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccInvoicingInvoiceUnitsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_invoicing_invoice_units.test"
+	resourceName := "aws_invoicing_invoice_unit.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckOrganizationManagementAccount(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.InvoicingServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInvoiceUnitDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInvoiceUnitsDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "invoice_units.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "invoice_units.0.arn", resourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(dataSourceName, "invoice_units.0.invoice_receiver", resourceName, "invoice_receiver"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "invoice_units.0.name", resourceName, names.AttrName),
+					resource.TestCheckResourceAttr(dataSourceName, "invoice_units.0.rule.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "invoice_units.0.rule.0.linked_accounts.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccInvoiceUnitsDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccInvoiceUnitConfig_basic(rName), `
+data "aws_invoicing_invoice_units" "test" {
+  filter {
+    names = [aws_invoicing_invoice_unit.test.name]
+  }
+}
+`)
+}
@@ -0,0 +1,41 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package invoicing
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/invoicing"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func RegisterSweepers() {
+	awsv2.Register("aws_invoicing_invoice_unit", sweepInvoiceUnits)
+}
+
+func sweepInvoiceUnits(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.InvoicingClient(ctx)
+
+	var sweepResources []sweep.Sweepable
+
+	pages := invoicing.NewListInvoiceUnitsPaginator(conn, &invoicing.ListInvoiceUnitsInput{})
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, invoiceUnit := range page.InvoiceUnits {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newInvoiceUnitResource, client,
+				framework.NewAttribute(names.AttrARN, aws.ToString(invoiceUnit.InvoiceUnitArn))))
+		}
+	}
+
+	return sweepResources, nil
+}
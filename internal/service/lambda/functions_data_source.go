@@ -12,6 +12,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/namevaluesfilters"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 // @SDKDataSource("aws_lambda_functions", name="Functions")
@@ -20,6 +22,7 @@ func dataSourceFunctions() *schema.Resource {
 		ReadWithoutTimeout: dataSourceFunctionsRead,
 
 		Schema: map[string]*schema.Schema{
+			names.AttrFilter: namevaluesfilters.Schema(),
 			"function_arns": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -38,6 +41,13 @@ func dataSourceFunctionsRead(ctx context.Context, d *schema.ResourceData, meta a
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).LambdaClient(ctx)
 
+	// ListFunctions does not support filtering, so filter client-side.
+	matcher, err := namevaluesfilters.New(d.Get(names.AttrFilter).(*schema.Set)).Matcher()
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
 	var functionARNs []string
 	var functionNames []string
 
@@ -51,6 +61,16 @@ func dataSourceFunctionsRead(ctx context.Context, d *schema.ResourceData, meta a
 		}
 
 		for _, v := range page.Functions {
+			ok, err := matcher.Match(v)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "filtering Lambda Functions: %s", err)
+			}
+
+			if !ok {
+				continue
+			}
+
 			functionARNs = append(functionARNs, aws.ToString(v.FunctionArn))
 			functionNames = append(functionNames, aws.ToString(v.FunctionName))
 		}
@@ -33,6 +33,30 @@ func TestAccLambdaFunctionsDataSource_basic(t *testing.T) {
 	})
 }
 
+func TestAccLambdaFunctionsDataSource_filter(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_lambda_functions.test"
+	resourceName := "aws_lambda_function.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFunctionsDataSourceConfig_filter(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "function_arns.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "function_arns.0", resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(dataSourceName, "function_names.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "function_names.0", rName),
+				),
+			},
+		},
+	})
+}
+
 func testAccFunctionsDataSourceConfig_basic(rName string) string {
 	return acctest.ConfigCompose(testAccFunctionConfig_basic(rName, rName, rName, rName), `
 data "aws_lambda_functions" "test" {
@@ -40,3 +64,19 @@ data "aws_lambda_functions" "test" {
 }
 `)
 }
+
+func testAccFunctionsDataSourceConfig_filter(rName string) string {
+	return acctest.ConfigCompose(testAccFunctionConfig_basic(rName, rName, rName, rName), `
+data "aws_lambda_functions" "test" {
+  filter {
+    name   = "FunctionName"
+    values = [aws_lambda_function.test.function_name]
+  }
+
+  filter {
+    name   = "Runtime"
+    values = [aws_lambda_function.test.runtime]
+  }
+}
+`)
+}
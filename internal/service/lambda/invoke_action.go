@@ -0,0 +1,141 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+)
+
+// @Action("aws_lambda_invoke", name="Invoke")
+func newInvokeAction(context.Context) (action.ActionWithConfigure, error) {
+	return &invokeAction{}, nil
+}
+
+type invokeAction struct {
+	framework.ActionWithConfigure
+}
+
+func (a *invokeAction) Schema(ctx context.Context, request action.SchemaRequest, response *action.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"client_context": schema.StringAttribute{
+				Optional: true,
+			},
+			"function_name": schema.StringAttribute{
+				Required: true,
+			},
+			"invocation_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.InvocationType](),
+				Optional:   true,
+			},
+			"log_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.LogType](),
+				Optional:   true,
+			},
+			"payload": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					validators.JSON(),
+				},
+			},
+			"qualifier": schema.StringAttribute{
+				Optional: true,
+			},
+			"wait_for_completion": schema.BoolAttribute{
+				Optional: true,
+				Validators: []validator.Bool{
+					boolvalidator.ConflictsWith(path.MatchRoot("invocation_type")),
+				},
+			},
+		},
+	}
+}
+
+func (a *invokeAction) Invoke(ctx context.Context, request action.InvokeRequest, response *action.InvokeResponse) {
+	var data invokeActionModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().LambdaClient(ctx)
+
+	functionName := fwflex.StringValueFromFramework(ctx, data.FunctionName)
+	input := lambda.InvokeInput{
+		ClientContext:  fwflex.StringFromFramework(ctx, data.ClientContext),
+		FunctionName:   aws.String(functionName),
+		InvocationType: awstypes.InvocationTypeRequestResponse,
+		LogType:        data.LogType.ValueEnum(),
+		Payload:        []byte(data.Payload.ValueString()),
+		Qualifier:      fwflex.StringFromFramework(ctx, data.Qualifier),
+	}
+	if !data.InvocationType.IsNull() {
+		input.InvocationType = data.InvocationType.ValueEnum()
+	} else if !data.WaitForCompletion.IsNull() && !data.WaitForCompletion.ValueBool() {
+		input.InvocationType = awstypes.InvocationTypeEvent
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Invoking Lambda Function %s (%s)...", functionName, input.InvocationType),
+	})
+
+	output, err := conn.Invoke(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("invoking Lambda Function (%s)", functionName), err.Error())
+
+		return
+	}
+
+	if v := output.FunctionError; v != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("invoking Lambda Function (%s)", functionName), fmt.Sprintf("%s: %s", aws.ToString(v), string(output.Payload)))
+
+		return
+	}
+
+	if v := output.LogResult; v != nil {
+		tflog.Debug(ctx, "Lambda Function log result", map[string]any{
+			"function_name": functionName,
+			"log_result":    aws.ToString(v),
+		})
+	}
+
+	if input.InvocationType == awstypes.InvocationTypeEvent {
+		response.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("Lambda Function %s invoked asynchronously", functionName),
+		})
+
+		return
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Lambda Function %s invoked (status code %d, executed version %s)", functionName, output.StatusCode, aws.ToString(output.ExecutedVersion)),
+	})
+}
+
+type invokeActionModel struct {
+	ClientContext     types.String                                `tfsdk:"client_context"`
+	FunctionName      types.String                                `tfsdk:"function_name"`
+	InvocationType    fwtypes.StringEnum[awstypes.InvocationType] `tfsdk:"invocation_type"`
+	LogType           fwtypes.StringEnum[awstypes.LogType]        `tfsdk:"log_type"`
+	Payload           types.String                                `tfsdk:"payload"`
+	Qualifier         types.String                                `tfsdk:"qualifier"`
+	WaitForCompletion types.Bool                                  `tfsdk:"wait_for_completion"`
+}
@@ -0,0 +1,108 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mgn
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/mgn"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func RegisterSweepers() {
+	awsv2.Register("aws_mgn_application", sweepApplications)
+	awsv2.Register("aws_mgn_launch_configuration_template", sweepLaunchConfigurationTemplates)
+	awsv2.Register("aws_mgn_replication_configuration_template", sweepReplicationConfigurationTemplates)
+	awsv2.Register("aws_mgn_wave", sweepWaves, "aws_mgn_application")
+}
+
+func sweepApplications(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.MgnClient(ctx)
+
+	var sweepResources []sweep.Sweepable
+
+	pages := mgn.NewListApplicationsPaginator(conn, &mgn.ListApplicationsInput{})
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Items {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newApplicationResource, client,
+				framework.NewAttribute(names.AttrID, aws.ToString(v.ApplicationID)),
+				framework.NewAttribute("wave_id", aws.ToString(v.WaveID))))
+		}
+	}
+
+	return sweepResources, nil
+}
+
+func sweepLaunchConfigurationTemplates(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.MgnClient(ctx)
+
+	var sweepResources []sweep.Sweepable
+
+	pages := mgn.NewDescribeLaunchConfigurationTemplatesPaginator(conn, &mgn.DescribeLaunchConfigurationTemplatesInput{})
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Items {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newLaunchConfigurationTemplateResource, client,
+				framework.NewAttribute(names.AttrID, aws.ToString(v.LaunchConfigurationTemplateID))))
+		}
+	}
+
+	return sweepResources, nil
+}
+
+func sweepReplicationConfigurationTemplates(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.MgnClient(ctx)
+
+	var sweepResources []sweep.Sweepable
+
+	pages := mgn.NewDescribeReplicationConfigurationTemplatesPaginator(conn, &mgn.DescribeReplicationConfigurationTemplatesInput{})
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Items {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newReplicationConfigurationTemplateResource, client,
+				framework.NewAttribute(names.AttrID, aws.ToString(v.ReplicationConfigurationTemplateID))))
+		}
+	}
+
+	return sweepResources, nil
+}
+
+func sweepWaves(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.MgnClient(ctx)
+
+	var sweepResources []sweep.Sweepable
+
+	pages := mgn.NewListWavesPaginator(conn, &mgn.ListWavesInput{})
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Items {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newWaveResource, client,
+				framework.NewAttribute(names.AttrID, aws.ToString(v.WaveID))))
+		}
+	}
+
+	return sweepResources, nil
+}
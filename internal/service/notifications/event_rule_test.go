@@ -0,0 +1,184 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package notifications_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/notifications"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfnotifications "github.com/hashicorp/terraform-provider-aws/internal/service/notifications"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccNotificationsEventRule_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var eventRule notifications.GetEventRuleOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_notifications_event_rule.test"
+	notificationConfigurationResourceName := "aws_notifications_notification_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.NotificationsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEventRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEventRuleConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckEventRuleExists(ctx, resourceName, &eventRule),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "event_type", "CloudWatch Alarm State Change"),
+					resource.TestCheckResourceAttrPair(resourceName, "notification_configuration_arn", notificationConfigurationResourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "regions.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "regions.*", acctest.Region()),
+					resource.TestCheckResourceAttr(resourceName, names.AttrSource, "aws.cloudwatch"),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, names.AttrARN),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: names.AttrARN,
+			},
+			{
+				Config: testAccEventRuleConfig_eventPattern(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckEventRuleExists(ctx, resourceName, &eventRule),
+					resource.TestCheckResourceAttrSet(resourceName, "event_pattern"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNotificationsEventRule_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var eventRule notifications.GetEventRuleOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_notifications_event_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.NotificationsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEventRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEventRuleConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckEventRuleExists(ctx, resourceName, &eventRule),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfnotifications.ResourceEventRule, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckEventRuleDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).NotificationsClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_notifications_event_rule" {
+				continue
+			}
+
+			_, err := tfnotifications.FindEventRuleByARN(ctx, conn, rs.Primary.Attributes[names.AttrARN])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("User Notifications Event Rule %s still exists", rs.Primary.Attributes[names.AttrARN])
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckEventRuleExists(ctx context.Context, n string, v *notifications.GetEventRuleOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).NotificationsClient(ctx)
+
+		output, err := tfnotifications.FindEventRuleByARN(ctx, conn, rs.Primary.Attributes[names.AttrARN])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccEventRuleConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+data "aws_region" "current" {}
+
+resource "aws_notifications_notification_configuration" "test" {
+  name        = %[1]q
+  description = %[1]q
+}
+
+resource "aws_notifications_event_rule" "test" {
+  notification_configuration_arn = aws_notifications_notification_configuration.test.arn
+  source                         = "aws.cloudwatch"
+  event_type                     = "CloudWatch Alarm State Change"
+  regions                        = [data.aws_region.current.name]
+}
+`, rName)
+}
+
+func testAccEventRuleConfig_eventPattern(rName string) string {
+	return fmt.Sprintf(`
+data "aws_region" "current" {}
+
+resource "aws_notifications_notification_configuration" "test" {
+  name        = %[1]q
+  description = %[1]q
+}
+
+resource "aws_notifications_event_rule" "test" {
+  notification_configuration_arn = aws_notifications_notification_configuration.test.arn
+  source                         = "aws.cloudwatch"
+  event_type                     = "CloudWatch Alarm State Change"
+  regions                        = [data.aws_region.current.name]
+
+  event_pattern = jsonencode({
+    detail = {
+      state = {
+        value = ["ALARM"]
+      }
+    }
+  })
+}
+`, rName)
+}
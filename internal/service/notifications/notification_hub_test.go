@@ -0,0 +1,135 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package notifications_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/notifications/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfnotifications "github.com/hashicorp/terraform-provider-aws/internal/service/notifications"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Notification hubs are account-wide, so these tests must not run in parallel.
+func TestAccNotificationsNotificationHub_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var notificationHub awstypes.NotificationHubOverview
+	resourceName := "aws_notifications_notification_hub.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckMultipleRegion(t, 2)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.NotificationsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckNotificationHubDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNotificationHubConfig_basic(acctest.AlternateRegion()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckNotificationHubExists(ctx, resourceName, &notificationHub),
+					resource.TestCheckResourceAttr(resourceName, "notification_hub_region", acctest.AlternateRegion()),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, "notification_hub_region"),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "notification_hub_region",
+			},
+		},
+	})
+}
+
+func TestAccNotificationsNotificationHub_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var notificationHub awstypes.NotificationHubOverview
+	resourceName := "aws_notifications_notification_hub.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckMultipleRegion(t, 2)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.NotificationsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckNotificationHubDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccNotificationHubConfig_basic(acctest.AlternateRegion()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckNotificationHubExists(ctx, resourceName, &notificationHub),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfnotifications.ResourceNotificationHub, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckNotificationHubDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).NotificationsClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_notifications_notification_hub" {
+				continue
+			}
+
+			_, err := tfnotifications.FindNotificationHubByRegion(ctx, conn, rs.Primary.Attributes["notification_hub_region"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("User Notifications Notification Hub %s still exists", rs.Primary.Attributes["notification_hub_region"])
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckNotificationHubExists(ctx context.Context, n string, v *awstypes.NotificationHubOverview) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).NotificationsClient(ctx)
+
+		output, err := tfnotifications.FindNotificationHubByRegion(ctx, conn, rs.Primary.Attributes["notification_hub_region"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccNotificationHubConfig_basic(region string) string {
+	return fmt.Sprintf(`
+resource "aws_notifications_notification_hub" "test" {
+  notification_hub_region = %[1]q
+}
+`, region)
+}
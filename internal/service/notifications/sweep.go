@@ -0,0 +1,41 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package notifications
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/notifications"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func RegisterSweepers() {
+	awsv2.Register("aws_notifications_notification_configuration", sweepNotificationConfigurations)
+}
+
+func sweepNotificationConfigurations(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.NotificationsClient(ctx)
+
+	var sweepResources []sweep.Sweepable
+
+	pages := notifications.NewListNotificationConfigurationsPaginator(conn, &notifications.ListNotificationConfigurationsInput{})
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, notificationConfiguration := range page.NotificationConfigurations {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newNotificationConfigurationResource, client,
+				framework.NewAttribute(names.AttrARN, aws.ToString(notificationConfiguration.Arn))))
+		}
+	}
+
+	return sweepResources, nil
+}
@@ -0,0 +1,41 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package notificationscontacts
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/notificationscontacts"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func RegisterSweepers() {
+	awsv2.Register("aws_notificationscontacts_email_contact", sweepEmailContacts)
+}
+
+func sweepEmailContacts(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.NotificationsContactsClient(ctx)
+
+	var sweepResources []sweep.Sweepable
+
+	pages := notificationscontacts.NewListEmailContactsPaginator(conn, &notificationscontacts.ListEmailContactsInput{})
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, emailContact := range page.EmailContacts {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newEmailContactResource, client,
+				framework.NewAttribute(names.AttrARN, aws.ToString(emailContact.Arn))))
+		}
+	}
+
+	return sweepResources, nil
+}
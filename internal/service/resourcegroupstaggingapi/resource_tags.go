@@ -0,0 +1,378 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resourcegroupstaggingapi
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi/types"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_resource_tags", name="Resource Tags")
+func newResourceTagsResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &resourceTagsResource{}
+
+	return r, nil
+}
+
+type resourceTagsResource struct {
+	framework.ResourceWithConfigure
+}
+
+func (r *resourceTagsResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"exclusive": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			names.AttrResourceARN: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrTags: schema.MapAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+			},
+		},
+	}
+}
+
+func (r *resourceTagsResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data resourceTagsResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	c := r.Meta()
+
+	resourceARN := data.ResourceARN.ValueString()
+	newTags := tftags.New(ctx, fwflex.ExpandFrameworkStringValueMap(ctx, data.Tags))
+	oldTags, err := findResourceTags(ctx, c, resourceARN)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading tags for resource (%s)", resourceARN), err.Error())
+
+		return
+	}
+
+	if !data.Exclusive.ValueBool() {
+		// Leave tags that aren't managed by this resource untouched.
+		oldTags = oldTags.Only(newTags)
+	}
+
+	if err := updateResourceTags(ctx, c, resourceARN, oldTags, newTags); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating tags for resource (%s)", resourceARN), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *resourceTagsResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data resourceTagsResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	c := r.Meta()
+
+	resourceARN := data.ResourceARN.ValueString()
+	tags, err := findResourceTags(ctx, c, resourceARN)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading tags for resource (%s)", resourceARN), err.Error())
+
+		return
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(c.IgnoreTagsConfig(ctx))
+
+	// On import there are no managed tags in state, so all tags are managed.
+	if !data.Exclusive.ValueBool() && !data.Tags.IsNull() {
+		tags = tags.Only(tftags.New(ctx, fwflex.ExpandFrameworkStringValueMap(ctx, data.Tags)))
+	}
+
+	data.Tags = fwflex.FlattenFrameworkStringValueMapLegacy(ctx, tags.Map())
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceTagsResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new, old resourceTagsResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	c := r.Meta()
+
+	resourceARN := new.ResourceARN.ValueString()
+	newTags := tftags.New(ctx, fwflex.ExpandFrameworkStringValueMap(ctx, new.Tags))
+	oldTags := tftags.New(ctx, fwflex.ExpandFrameworkStringValueMap(ctx, old.Tags))
+
+	if new.Exclusive.ValueBool() {
+		tags, err := findResourceTags(ctx, c, resourceARN)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("reading tags for resource (%s)", resourceARN), err.Error())
+
+			return
+		}
+
+		oldTags = tags.IgnoreAWS().IgnoreConfig(c.IgnoreTagsConfig(ctx))
+	}
+
+	if err := updateResourceTags(ctx, c, resourceARN, oldTags, newTags); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating tags for resource (%s)", resourceARN), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *resourceTagsResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data resourceTagsResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	c := r.Meta()
+
+	resourceARN := data.ResourceARN.ValueString()
+	oldTags := tftags.New(ctx, fwflex.ExpandFrameworkStringValueMap(ctx, data.Tags))
+
+	err := updateResourceTags(ctx, c, resourceARN, oldTags, tftags.New(ctx, nil))
+
+	if tfresource.NotFound(err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting tags for resource (%s)", resourceARN), err.Error())
+
+		return
+	}
+}
+
+func (r *resourceTagsResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrResourceARN), request, response)
+}
+
+// findResourceTags returns the tags on the specified resource.
+// The Resource Groups Tagging API is used unless it doesn't return the resource, in which case the
+// resource's service package tag listing method is used if there is one.
+func findResourceTags(ctx context.Context, c *conns.AWSClient, resourceARN string) (tftags.KeyValueTags, error) {
+	tags, err := findResourceTagsByARN(ctx, c.ResourceGroupsTaggingAPIClient(ctx), resourceARN)
+
+	if !tfresource.NotFound(err) {
+		return tags, err
+	}
+
+	// The resource has no tags or the Resource Groups Tagging API doesn't support its type.
+	sp, identifier, err := servicePackageForARN(ctx, c, resourceARN)
+
+	if err != nil {
+		return tftags.New(ctx, nil), nil
+	}
+
+	lister, ok := sp.(tftags.ServiceTagLister)
+	if !ok {
+		return tftags.New(ctx, nil), nil
+	}
+
+	ctx = tftags.NewContext(ctx, nil, nil)
+	if err := lister.ListTags(ctx, c, identifier); err != nil {
+		return nil, err
+	}
+
+	if inContext, ok := tftags.FromContext(ctx); ok {
+		return inContext.TagsOut.UnwrapOrDefault(), nil
+	}
+
+	return tftags.New(ctx, nil), nil
+}
+
+func findResourceTagsByARN(ctx context.Context, conn *resourcegroupstaggingapi.Client, resourceARN string) (tftags.KeyValueTags, error) {
+	input := &resourcegroupstaggingapi.GetResourcesInput{
+		ResourceARNList: []string{resourceARN},
+	}
+
+	pages := resourcegroupstaggingapi.NewGetResourcesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.ResourceTagMappingList {
+			if aws.ToString(v.ResourceARN) == resourceARN {
+				return keyValueTags(ctx, v.Tags), nil
+			}
+		}
+	}
+
+	return nil, &retry.NotFoundError{
+		LastRequest: input,
+	}
+}
+
+// updateResourceTags updates the tags on the specified resource.
+// The Resource Groups Tagging API is used unless it fails for the resource, in which case the
+// resource's service package tag updating method is used if there is one.
+func updateResourceTags(ctx context.Context, c *conns.AWSClient, resourceARN string, oldTags, newTags tftags.KeyValueTags) error {
+	err := updateResourceTagsByARN(ctx, c.ResourceGroupsTaggingAPIClient(ctx), resourceARN, oldTags, newTags)
+
+	if err == nil {
+		return nil
+	}
+
+	sp, identifier, spErr := servicePackageForARN(ctx, c, resourceARN)
+
+	if spErr != nil {
+		return err
+	}
+
+	updater, ok := sp.(tftags.ServiceTagUpdater)
+	if !ok {
+		return err
+	}
+
+	tflog.Debug(ctx, "Resource Groups Tagging API failed, using service package tagging", map[string]any{
+		"ServicePackage":      sp.ServicePackageName(),
+		names.AttrResourceARN: resourceARN,
+		"error":               err.Error(),
+	})
+
+	return updater.UpdateTags(ctx, c, identifier, oldTags, newTags)
+}
+
+func updateResourceTagsByARN(ctx context.Context, conn *resourcegroupstaggingapi.Client, resourceARN string, oldTags, newTags tftags.KeyValueTags) error {
+	removedTags := oldTags.Removed(newTags).IgnoreAWS()
+	if len(removedTags) > 0 {
+		input := &resourcegroupstaggingapi.UntagResourcesInput{
+			ResourceARNList: []string{resourceARN},
+			TagKeys:         removedTags.Keys(),
+		}
+
+		output, err := conn.UntagResources(ctx, input)
+
+		if err == nil {
+			err = failedResourcesError(output.FailedResourcesMap)
+		}
+
+		if err != nil {
+			return fmt.Errorf("untagging resource (%s): %w", resourceARN, err)
+		}
+	}
+
+	updatedTags := oldTags.Updated(newTags).IgnoreAWS()
+	if len(updatedTags) > 0 {
+		input := &resourcegroupstaggingapi.TagResourcesInput{
+			ResourceARNList: []string{resourceARN},
+			Tags:            updatedTags.Map(),
+		}
+
+		output, err := conn.TagResources(ctx, input)
+
+		if err == nil {
+			err = failedResourcesError(output.FailedResourcesMap)
+		}
+
+		if err != nil {
+			return fmt.Errorf("tagging resource (%s): %w", resourceARN, err)
+		}
+	}
+
+	return nil
+}
+
+func failedResourcesError(apiObjects map[string]awstypes.FailureInfo) error {
+	for _, apiObject := range apiObjects {
+		return fmt.Errorf("%s: %s", apiObject.ErrorCode, aws.ToString(apiObject.ErrorMessage))
+	}
+
+	return nil
+}
+
+// servicePackageForARN returns the service package for the specified ARN's service
+// and the identifier used by the service package's tagging methods.
+func servicePackageForARN(ctx context.Context, c *conns.AWSClient, resourceARN string) (conns.ServicePackage, string, error) {
+	v, err := arn.Parse(resourceARN)
+
+	if err != nil {
+		return nil, "", err
+	}
+
+	name, err := names.ProviderPackageForAlias(v.Service)
+
+	if err != nil {
+		return nil, "", err
+	}
+
+	sp := c.ServicePackage(ctx, name)
+	if sp == nil {
+		return nil, "", fmt.Errorf("unknown service package: %s", name)
+	}
+
+	identifier := resourceARN
+	switch name {
+	case names.EC2:
+		// EC2 tags resources by ID, e.g. "instance/i-0123456789abcdef0".
+		if _, id, ok := strings.Cut(v.Resource, "/"); ok {
+			identifier = id
+		}
+	}
+
+	return sp, identifier, nil
+}
+
+type resourceTagsResourceModel struct {
+	Exclusive   types.Bool  `tfsdk:"exclusive"`
+	ResourceARN fwtypes.ARN `tfsdk:"resource_arn"`
+	Tags        types.Map   `tfsdk:"tags"`
+}
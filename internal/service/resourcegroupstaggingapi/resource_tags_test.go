@@ -0,0 +1,182 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resourcegroupstaggingapi_test
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"strings"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfresourcegroupstaggingapi "github.com/hashicorp/terraform-provider-aws/internal/service/resourcegroupstaggingapi"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccResourceGroupsTaggingAPIResourceTags_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_resource_tags.test"
+	topicResourceName := "aws_sns_topic.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ResourceGroupsTaggingAPIServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckResourceTagsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceTagsConfig_basic(rName, false, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "exclusive", acctest.CtFalse),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrResourceARN, topicResourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "1"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+					testAccCheckResourceTags(ctx, topicResourceName, map[string]string{
+						"Unmanaged":    rName,
+						acctest.CtKey1: acctest.CtValue1,
+					}),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, names.AttrResourceARN),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: names.AttrResourceARN,
+				ImportStateVerifyIgnore:              []string{names.AttrTags},
+			},
+			{
+				Config: testAccResourceTagsConfig_basic(rName, false, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "1"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+					testAccCheckResourceTags(ctx, topicResourceName, map[string]string{
+						"Unmanaged":    rName,
+						acctest.CtKey2: acctest.CtValue2,
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceGroupsTaggingAPIResourceTags_exclusive(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_resource_tags.test"
+	topicResourceName := "aws_sns_topic.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ResourceGroupsTaggingAPIServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckResourceTagsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceTagsConfig_basic(rName, true, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "exclusive", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "1"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+					testAccCheckResourceTags(ctx, topicResourceName, map[string]string{
+						acctest.CtKey1: acctest.CtValue1,
+					}),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, names.AttrResourceARN),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: names.AttrResourceARN,
+				ImportStateVerifyIgnore:              []string{"exclusive"},
+			},
+		},
+	})
+}
+
+func testAccCheckResourceTagsDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		c := acctest.Provider.Meta().(*conns.AWSClient)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_resource_tags" {
+				continue
+			}
+
+			tags, err := tfresourcegroupstaggingapi.FindResourceTags(ctx, c, rs.Primary.Attributes[names.AttrResourceARN])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			for k := range rs.Primary.Attributes {
+				if key, ok := strings.CutPrefix(k, "tags."); ok && key != "%" && tags.KeyExists(key) {
+					return fmt.Errorf("Resource Tags %s still exists", rs.Primary.Attributes[names.AttrResourceARN])
+				}
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckResourceTags(ctx context.Context, n string, want map[string]string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		c := acctest.Provider.Meta().(*conns.AWSClient)
+
+		tags, err := tfresourcegroupstaggingapi.FindResourceTags(ctx, c, rs.Primary.Attributes[names.AttrARN])
+
+		if err != nil {
+			return err
+		}
+
+		if got := tags.IgnoreAWS().Map(); !maps.Equal(got, want) {
+			return fmt.Errorf("%s tags = %v, want %v", n, got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccResourceTagsConfig_basic(rName string, exclusive bool, tagKey, tagValue string) string {
+	return fmt.Sprintf(`
+resource "aws_sns_topic" "test" {
+  name = %[1]q
+
+  tags = {
+    Unmanaged = %[1]q
+  }
+
+  lifecycle {
+    ignore_changes = [tags, tags_all]
+  }
+}
+
+resource "aws_resource_tags" "test" {
+  resource_arn = aws_sns_topic.test.arn
+  exclusive    = %[2]t
+
+  tags = {
+    %[3]q = %[4]q
+  }
+}
+`, rName, exclusive, tagKey, tagValue)
+}
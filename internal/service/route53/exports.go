@@ -5,6 +5,8 @@ package route53
 
 // Exports for use in other modules.
 var (
-	DeleteHostedZone                   = deleteHostedZone
-	FindPublicHostedZoneIDByDomainName = findPublicHostedZoneIDByDomainName
+	DeleteHostedZone                                  = deleteHostedZone
+	FindPublicHostedZoneIDByDomainName                = findPublicHostedZoneIDByDomainName
+	FindPublicHostedZoneIDByLongestMatchingDomainName = findPublicHostedZoneIDByLongestMatchingDomainName
+	WaitChangeInsync                                  = waitChangeInsync
 )
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"log"
 	"slices"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -551,6 +552,41 @@ func findPublicHostedZoneIDByDomainName(ctx context.Context, conn *route53.Clien
 	return &hostedZoneID, nil
 }
 
+// findPublicHostedZoneIDByLongestMatchingDomainName returns the ID of the public hosted zone whose name is the longest suffix of the specified domain name.
+func findPublicHostedZoneIDByLongestMatchingDomainName(ctx context.Context, conn *route53.Client, domainName string) (*string, error) {
+	domainName = normalizeDomainName(domainName)
+	input := route53.ListHostedZonesInput{}
+
+	hostedZones, err := findHostedZones(ctx, conn, &input, func(v *awstypes.HostedZone) bool {
+		if v.Config != nil && v.Config.PrivateZone {
+			return false
+		}
+
+		name := normalizeDomainName(v.Name)
+
+		return domainName == name || strings.HasSuffix(domainName, "."+name)
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	var hostedZone *awstypes.HostedZone
+	for _, v := range hostedZones {
+		if hostedZone == nil || len(normalizeDomainName(v.Name)) > len(normalizeDomainName(hostedZone.Name)) {
+			hostedZone = &v
+		}
+	}
+
+	if hostedZone == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	hostedZoneID := cleanZoneID(aws.ToString(hostedZone.Id))
+
+	return &hostedZoneID, nil
+}
+
 // See https://docs.aws.amazon.com/service-authorization/latest/reference/list_amazonroute53.html#amazonroute53-resources-for-iam-policies.
 func zoneARN(ctx context.Context, c *conns.AWSClient, id string) string {
 	return c.GlobalARNNoAccount(ctx, "route53", "hostedzone/"+id)
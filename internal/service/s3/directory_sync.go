@@ -0,0 +1,659 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"mime"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/s3/manager"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfmaps "github.com/hashicorp/terraform-provider-aws/internal/maps"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	directorySyncResourceIDPartCount = 2
+	directorySyncDefaultContentType  = "application/octet-stream"
+	directorySyncDeleteBatchSize     = 1000
+)
+
+// @FrameworkResource("aws_s3_directory_sync", name="Directory Sync")
+func newDirectorySyncResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &directorySyncResource{}
+
+	return r, nil
+}
+
+type directorySyncResource struct {
+	framework.ResourceWithConfigure
+}
+
+func (r *directorySyncResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrBucket: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"cache_control": schema.StringAttribute{
+				Optional: true,
+			},
+			"concurrency": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				Default:  int64default.StaticInt64(10),
+				Validators: []validator.Int64{
+					int64validator.Between(1, 64),
+				},
+			},
+			"delete_removed_objects": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+			},
+			"exclude": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"files": schema.MapAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"key_prefix": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(""),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrSource: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrRule: schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[directorySyncRuleModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"cache_control": schema.StringAttribute{
+							Optional: true,
+						},
+						"content_encoding": schema.StringAttribute{
+							Optional: true,
+						},
+						names.AttrContentType: schema.StringAttribute{
+							Optional: true,
+						},
+						"metadata": schema.MapAttribute{
+							CustomType:  fwtypes.MapOfStringType,
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+						"pattern": schema.StringAttribute{
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *directorySyncResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data directorySyncResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	bucket, prefix := data.Bucket.ValueString(), data.KeyPrefix.ValueString()
+	id, _ := flex.FlattenResourceId([]string{bucket, prefix}, directorySyncResourceIDPartCount, true)
+	conn := r.directorySyncClient(ctx, bucket)
+
+	files, err := scanDirectorySyncSource(ctx, &data)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading S3 Directory Sync (%s) source (%s)", id, data.Source.ValueString()), err.Error())
+
+		return
+	}
+
+	if err := uploadDirectorySyncFiles(ctx, conn, bucket, files, int(data.Concurrency.ValueInt64())); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating S3 Directory Sync (%s)", id), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = types.StringValue(id)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *directorySyncResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data directorySyncResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	bucket, prefix := data.Bucket.ValueString(), data.KeyPrefix.ValueString()
+	conn := r.directorySyncClient(ctx, bucket)
+
+	_, err := findBucket(ctx, conn, bucket)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading S3 Directory Sync (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	existing, err := findObjectKeysByPrefix(ctx, conn, bucket, prefix)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading S3 Directory Sync (%s) objects", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Only objects uploaded by this resource are tracked; other objects under the prefix are never modified.
+	// Objects deleted outside of Terraform are dropped so that they are uploaded again.
+	tracked := fwflex.ExpandFrameworkStringValueMap(ctx, data.Files)
+	files := make(map[string]string, len(tracked))
+	for _, key := range existing {
+		if v, ok := tracked[key]; ok {
+			files[key] = v
+		}
+	}
+	data.Files = fwflex.FlattenFrameworkStringValueMap(ctx, files)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *directorySyncResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new, old directorySyncResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	bucket := new.Bucket.ValueString()
+	id := new.ID.ValueString()
+	conn := r.directorySyncClient(ctx, bucket)
+
+	files, err := scanDirectorySyncSource(ctx, &new)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading S3 Directory Sync (%s) source (%s)", id, new.Source.ValueString()), err.Error())
+
+		return
+	}
+
+	// Only upload new or changed files.
+	oldFiles := fwflex.ExpandFrameworkStringValueMap(ctx, old.Files)
+	changed := make(map[string]*directorySyncFile)
+	for key, file := range files {
+		if v, ok := oldFiles[key]; !ok || v != file.hash {
+			changed[key] = file
+		}
+	}
+
+	tflog.Debug(ctx, "Syncing S3 directory", map[string]any{
+		"changed": len(changed),
+		"total":   len(files),
+	})
+
+	if err := uploadDirectorySyncFiles(ctx, conn, bucket, changed, int(new.Concurrency.ValueInt64())); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating S3 Directory Sync (%s)", id), err.Error())
+
+		return
+	}
+
+	// Only objects previously uploaded by this resource are deleted.
+	if new.DeleteRemovedObjects.ValueBool() {
+		var keys []string
+		for key := range oldFiles {
+			if _, ok := files[key]; !ok {
+				keys = append(keys, key)
+			}
+		}
+
+		if err := deleteDirectorySyncObjects(ctx, conn, bucket, keys); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating S3 Directory Sync (%s)", id), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *directorySyncResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data directorySyncResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	bucket := data.Bucket.ValueString()
+	conn := r.directorySyncClient(ctx, bucket)
+
+	keys := tfmaps.Keys(fwflex.ExpandFrameworkStringValueMap(ctx, data.Files))
+	if err := deleteDirectorySyncObjects(ctx, conn, bucket, keys); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting S3 Directory Sync (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *directorySyncResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	// Nothing to do on destroy.
+	if request.Plan.Raw.IsNull() {
+		return
+	}
+
+	var data directorySyncResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// The set of files can't be known until all the inputs are known.
+	if !data.hasKnownSource(ctx) {
+		data.Files = types.MapUnknown(types.StringType)
+		response.Diagnostics.Append(response.Plan.Set(ctx, &data)...)
+
+		return
+	}
+
+	files, err := scanDirectorySyncSource(ctx, &data)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading S3 Directory Sync source (%s)", data.Source.ValueString()), err.Error())
+
+		return
+	}
+
+	hashes := make(map[string]string, len(files))
+	for key, file := range files {
+		hashes[key] = file.hash
+	}
+
+	data.Files = fwflex.FlattenFrameworkStringValueMap(ctx, hashes)
+	response.Diagnostics.Append(response.Plan.Set(ctx, &data)...)
+}
+
+func (r *directorySyncResource) directorySyncClient(ctx context.Context, bucket string) *s3.Client {
+	if isDirectoryBucket(bucket) {
+		return r.Meta().S3ExpressClient(ctx)
+	}
+
+	return r.Meta().S3Client(ctx)
+}
+
+type directorySyncResourceModel struct {
+	Bucket               types.String                                            `tfsdk:"bucket"`
+	CacheControl         types.String                                            `tfsdk:"cache_control"`
+	Concurrency          types.Int64                                             `tfsdk:"concurrency"`
+	DeleteRemovedObjects types.Bool                                              `tfsdk:"delete_removed_objects"`
+	Exclude              fwtypes.SetOfString                                     `tfsdk:"exclude"`
+	Files                types.Map                                               `tfsdk:"files"`
+	ID                   types.String                                            `tfsdk:"id"`
+	KeyPrefix            types.String                                            `tfsdk:"key_prefix"`
+	Rules                fwtypes.ListNestedObjectValueOf[directorySyncRuleModel] `tfsdk:"rule"`
+	Source               types.String                                            `tfsdk:"source"`
+}
+
+// hasKnownSource returns whether all the values that determine the set of files to upload are known.
+func (m *directorySyncResourceModel) hasKnownSource(ctx context.Context) bool {
+	if m.Source.IsUnknown() || m.KeyPrefix.IsUnknown() || m.CacheControl.IsUnknown() || m.Exclude.IsUnknown() || m.Rules.IsUnknown() {
+		return false
+	}
+
+	rules, diags := m.Rules.ToSlice(ctx)
+	if diags.HasError() {
+		return false
+	}
+
+	for _, rule := range rules {
+		if rule.CacheControl.IsUnknown() || rule.ContentEncoding.IsUnknown() || rule.ContentType.IsUnknown() || rule.Metadata.IsUnknown() || rule.Pattern.IsUnknown() {
+			return false
+		}
+	}
+
+	return true
+}
+
+type directorySyncRuleModel struct {
+	CacheControl    types.String        `tfsdk:"cache_control"`
+	ContentEncoding types.String        `tfsdk:"content_encoding"`
+	ContentType     types.String        `tfsdk:"content_type"`
+	Metadata        fwtypes.MapOfString `tfsdk:"metadata"`
+	Pattern         types.String        `tfsdk:"pattern"`
+}
+
+// directorySyncFile represents a local file to be uploaded to S3.
+type directorySyncFile struct {
+	cacheControl    string
+	contentEncoding string
+	contentType     string
+	hash            string
+	key             string
+	metadata        map[string]string
+	path            string
+}
+
+// directorySyncPatternMatch reports whether the slash-separated relative path matches the pattern.
+// Patterns without a '/' are matched against the file name only.
+func directorySyncPatternMatch(pattern, name string) bool {
+	if !strings.Contains(pattern, "/") {
+		name = path.Base(name)
+	}
+
+	ok, _ := path.Match(pattern, name)
+
+	return ok
+}
+
+// scanDirectorySyncSource walks the source directory and returns the files to upload keyed by object key.
+func scanDirectorySyncSource(ctx context.Context, data *directorySyncResourceModel) (map[string]*directorySyncFile, error) {
+	source := data.Source.ValueString()
+	prefix := data.KeyPrefix.ValueString()
+	exclude := fwflex.ExpandFrameworkStringValueSet(ctx, data.Exclude)
+
+	rules, diags := data.Rules.ToSlice(ctx)
+	if diags.HasError() {
+		return nil, fwdiag.DiagnosticsError(diags)
+	}
+
+	for _, v := range exclude {
+		if _, err := path.Match(v, ""); err != nil {
+			return nil, fmt.Errorf("invalid exclude pattern %q: %w", v, err)
+		}
+	}
+	for _, rule := range rules {
+		if _, err := path.Match(rule.Pattern.ValueString(), ""); err != nil {
+			return nil, fmt.Errorf("invalid rule pattern %q: %w", rule.Pattern.ValueString(), err)
+		}
+	}
+
+	files := make(map[string]*directorySyncFile)
+	err := filepath.WalkDir(source, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(source, p)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+
+		if rel != "." && slices.ContainsFunc(exclude, func(v string) bool { return directorySyncPatternMatch(v, rel) }) {
+			if d.IsDir() {
+				return filepath.SkipDir
+			}
+
+			return nil
+		}
+
+		if !d.Type().IsRegular() {
+			return nil
+		}
+
+		file := &directorySyncFile{
+			cacheControl: data.CacheControl.ValueString(),
+			contentType:  mime.TypeByExtension(path.Ext(rel)),
+			key:          prefix + rel,
+			metadata:     make(map[string]string),
+			path:         p,
+		}
+		if file.contentType == "" {
+			file.contentType = directorySyncDefaultContentType
+		}
+
+		// Later rules take precedence.
+		for _, rule := range rules {
+			if !directorySyncPatternMatch(rule.Pattern.ValueString(), rel) {
+				continue
+			}
+
+			if v := rule.CacheControl.ValueString(); v != "" {
+				file.cacheControl = v
+			}
+			if v := rule.ContentEncoding.ValueString(); v != "" {
+				file.contentEncoding = v
+			}
+			if v := rule.ContentType.ValueString(); v != "" {
+				file.contentType = v
+			}
+			for k, v := range fwflex.ExpandFrameworkStringValueMap(ctx, rule.Metadata) {
+				file.metadata[k] = v
+			}
+		}
+
+		file.hash, err = directorySyncFileHash(file)
+		if err != nil {
+			return err
+		}
+
+		files[file.key] = file
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return files, nil
+}
+
+// directorySyncFileHash returns a hash of the file's content and the object settings applied to it,
+// so that a change to either results in the object being uploaded again.
+func directorySyncFileHash(file *directorySyncFile) (string, error) {
+	f, err := os.Open(file.path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+
+	fmt.Fprintf(h, "\x00%s\x00%s\x00%s", file.cacheControl, file.contentEncoding, file.contentType)
+	keys := tfmaps.Keys(file.metadata)
+	slices.Sort(keys)
+	for _, k := range keys {
+		fmt.Fprintf(h, "\x00%s=%s", k, file.metadata[k])
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// uploadDirectorySyncFiles uploads files in parallel. Large files are uploaded using multipart upload.
+func uploadDirectorySyncFiles(ctx context.Context, conn *s3.Client, bucket string, files map[string]*directorySyncFile, concurrency int) error {
+	uploader := manager.NewUploader(conn)
+
+	var (
+		mu   sync.Mutex
+		errs []error
+		wg   sync.WaitGroup
+	)
+	sem := make(chan struct{}, max(concurrency, 1))
+
+	for _, file := range files {
+		wg.Add(1)
+		sem <- struct{}{}
+
+		go func() {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			if err := uploadDirectorySyncFile(ctx, uploader, bucket, file); err != nil {
+				mu.Lock()
+				errs = append(errs, err)
+				mu.Unlock()
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	return errors.Join(errs...)
+}
+
+func uploadDirectorySyncFile(ctx context.Context, uploader *manager.Uploader, bucket string, file *directorySyncFile) error {
+	f, err := os.Open(file.path)
+	if err != nil {
+		return fmt.Errorf("opening %s: %w", file.path, err)
+	}
+	defer f.Close()
+
+	input := s3.PutObjectInput{
+		Body:        f,
+		Bucket:      aws.String(bucket),
+		ContentType: aws.String(file.contentType),
+		Key:         aws.String(file.key),
+	}
+	if file.cacheControl != "" {
+		input.CacheControl = aws.String(file.cacheControl)
+	}
+	if file.contentEncoding != "" {
+		input.ContentEncoding = aws.String(file.contentEncoding)
+	}
+	if len(file.metadata) > 0 {
+		input.Metadata = file.metadata
+	}
+
+	tflog.Debug(ctx, "Uploading S3 Object", map[string]any{
+		names.AttrBucket: bucket,
+		names.AttrKey:    file.key,
+		names.AttrSource: file.path,
+	})
+
+	if _, err := uploader.Upload(ctx, &input); err != nil {
+		return fmt.Errorf("uploading S3 Object (%s) to Bucket (%s): %w", file.key, bucket, err)
+	}
+
+	return nil
+}
+
+// deleteDirectorySyncObjects deletes the specified objects in batches.
+func deleteDirectorySyncObjects(ctx context.Context, conn *s3.Client, bucket string, keys []string) error {
+	var errs []error
+
+	for chunk := range slices.Chunk(keys, directorySyncDeleteBatchSize) {
+		input := s3.DeleteObjectsInput{
+			Bucket: aws.String(bucket),
+			Delete: &awstypes.Delete{
+				Quiet: aws.Bool(true),
+			},
+		}
+		for _, key := range chunk {
+			input.Delete.Objects = append(input.Delete.Objects, awstypes.ObjectIdentifier{
+				Key: aws.String(key),
+			})
+		}
+
+		output, err := conn.DeleteObjects(ctx, &input)
+
+		if tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket) {
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("deleting S3 Bucket (%s) objects: %w", bucket, err)
+		}
+
+		for _, v := range output.Errors {
+			errs = append(errs, fmt.Errorf("deleting S3 Bucket (%s) object (%s): %s: %s", bucket, aws.ToString(v.Key), aws.ToString(v.Code), aws.ToString(v.Message)))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// findObjectKeysByPrefix returns the keys of all objects with the specified prefix.
+func findObjectKeysByPrefix(ctx context.Context, conn *s3.Client, bucket, prefix string) ([]string, error) {
+	input := s3.ListObjectsV2Input{
+		Bucket: aws.String(bucket),
+	}
+	if prefix != "" {
+		input.Prefix = aws.String(prefix)
+	}
+	var keys []string
+
+	pages := s3.NewListObjectsV2Paginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Contents {
+			keys = append(keys, aws.ToString(v.Key))
+		}
+	}
+
+	return keys, nil
+}
@@ -0,0 +1,380 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfs3 "github.com/hashicorp/terraform-provider-aws/internal/service/s3"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestDirectorySyncPatternMatch(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name    string
+		pattern string
+		path    string
+		want    bool
+	}{
+		{
+			name:    "extension in root",
+			pattern: "*.html",
+			path:    "index.html",
+			want:    true,
+		},
+		{
+			name:    "extension in subdirectory",
+			pattern: "*.html",
+			path:    "docs/index.html",
+			want:    true,
+		},
+		{
+			name:    "extension mismatch",
+			pattern: "*.html",
+			path:    "css/site.css",
+		},
+		{
+			name:    "directory pattern",
+			pattern: "assets/*",
+			path:    "assets/logo.png",
+			want:    true,
+		},
+		{
+			name:    "directory pattern nested",
+			pattern: "assets/*",
+			path:    "assets/img/logo.png",
+		},
+		{
+			name:    "directory pattern other directory",
+			pattern: "assets/*",
+			path:    "docs/logo.png",
+		},
+		{
+			name:    "directory name",
+			pattern: ".git",
+			path:    ".git",
+			want:    true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := tfs3.DirectorySyncPatternMatch(testCase.pattern, testCase.path), testCase.want; got != want {
+				t.Errorf("DirectorySyncPatternMatch(%q, %q) = %v, want %v", testCase.pattern, testCase.path, got, want)
+			}
+		})
+	}
+}
+
+func TestAccS3DirectorySync_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_directory_sync.test"
+	source := testAccDirectorySyncSource(t, map[string]string{
+		"index.html":      "<html></html>",
+		"css/site.css":    "body {}",
+		"js/app.js":       "console.log('hello');",
+		".git/HEAD":       "ref: refs/heads/main",
+		"data/notes.none": "no known extension",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectorySyncConfig_basic(rName, source),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, names.AttrBucket, rName),
+					resource.TestCheckResourceAttr(resourceName, "concurrency", "10"),
+					resource.TestCheckResourceAttr(resourceName, "delete_removed_objects", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "files.%", "4"),
+					resource.TestCheckResourceAttrSet(resourceName, "files.index.html"),
+					resource.TestCheckResourceAttr(resourceName, "key_prefix", ""),
+					testAccCheckDirectorySyncObjects(ctx, rName, "", "css/site.css", "data/notes.none", "index.html", "js/app.js"),
+					testAccCheckDirectorySyncObjectContentType(ctx, rName, "index.html", "text/html; charset=utf-8"),
+					testAccCheckDirectorySyncObjectContentType(ctx, rName, "data/notes.none", "application/octet-stream"),
+				),
+			},
+			{
+				Config:   testAccDirectorySyncConfig_basic(rName, source),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccS3DirectorySync_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_directory_sync.test"
+	source := testAccDirectorySyncSource(t, map[string]string{
+		"index.html": "<html></html>",
+		"error.html": "<html>error</html>",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectorySyncConfig_basic(rName, source),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "files.%", "2"),
+					testAccCheckDirectorySyncObjects(ctx, rName, "", "error.html", "index.html"),
+				),
+			},
+			{
+				PreConfig: func() {
+					testAccDirectorySyncWriteFiles(t, source, map[string]string{
+						"index.html":      "<html>updated</html>",
+						"about/team.html": "<html>team</html>",
+					})
+					if err := os.Remove(filepath.Join(source, "error.html")); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccDirectorySyncConfig_basic(rName, source),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "files.%", "2"),
+					testAccCheckDirectorySyncObjects(ctx, rName, "", "about/team.html", "index.html"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3DirectorySync_preExistingObjects(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_directory_sync.test"
+	source := testAccDirectorySyncSource(t, map[string]string{
+		"index.html": "<html></html>",
+		"error.html": "<html>error</html>",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectorySyncConfig_preExistingObjects(rName, source),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "delete_removed_objects", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "files.%", "2"),
+					testAccCheckDirectorySyncObjects(ctx, rName, "", "error.html", "index.html", "logs/access.log", "unrelated.txt"),
+				),
+			},
+			{
+				Config:   testAccDirectorySyncConfig_preExistingObjects(rName, source),
+				PlanOnly: true,
+			},
+			{
+				PreConfig: func() {
+					if err := os.Remove(filepath.Join(source, "error.html")); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccDirectorySyncConfig_preExistingObjects(rName, source),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "files.%", "1"),
+					testAccCheckDirectorySyncObjects(ctx, rName, "", "index.html", "logs/access.log", "unrelated.txt"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3DirectorySync_keyPrefixAndRules(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_directory_sync.test"
+	source := testAccDirectorySyncSource(t, map[string]string{
+		"index.html":      "<html></html>",
+		"assets/app.js":   "console.log('hello');",
+		"assets/app.wasm": "wasm",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectorySyncConfig_keyPrefixAndRules(rName, source, "max-age=60"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "files.%", "3"),
+					resource.TestCheckResourceAttr(resourceName, "key_prefix", "site/"),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "2"),
+					testAccCheckDirectorySyncObjects(ctx, rName, "site/", "site/assets/app.js", "site/assets/app.wasm", "site/index.html"),
+					testAccCheckDirectorySyncObjectContentType(ctx, rName, "site/assets/app.wasm", "application/wasm"),
+				),
+			},
+			{
+				Config: testAccDirectorySyncConfig_keyPrefixAndRules(rName, source, "max-age=3600"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "files.%", "3"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.cache_control", "max-age=3600"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDirectorySyncSource(t *testing.T, files map[string]string) string {
+	t.Helper()
+
+	dir := t.TempDir()
+	testAccDirectorySyncWriteFiles(t, dir, files)
+
+	return dir
+}
+
+func testAccDirectorySyncWriteFiles(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+
+	for name, content := range files {
+		p := filepath.Join(dir, filepath.FromSlash(name))
+
+		if err := os.MkdirAll(filepath.Dir(p), 0o755); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := os.WriteFile(p, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func testAccCheckDirectorySyncObjects(ctx context.Context, bucket, prefix string, want ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		got, err := tfs3.FindObjectKeysByPrefix(ctx, conn, bucket, prefix)
+
+		if err != nil {
+			return err
+		}
+
+		slices.Sort(got)
+		if !slices.Equal(got, want) {
+			return fmt.Errorf("S3 Bucket (%s) objects = %v, want %v", bucket, got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDirectorySyncObjectContentType(ctx context.Context, bucket, key, want string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		output, err := tfs3.FindObjectByBucketAndKey(ctx, conn, bucket, key, "", "")
+
+		if err != nil {
+			return err
+		}
+
+		if got := aws.ToString(output.ContentType); got != want {
+			return fmt.Errorf("S3 Object (%s) Content-Type = %q, want %q", key, got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccDirectorySyncConfig_basic(rName, source string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_directory_sync" "test" {
+  bucket  = aws_s3_bucket.test.bucket
+  source  = %[2]q
+  exclude = [".git"]
+}
+`, rName, source)
+}
+
+func testAccDirectorySyncConfig_keyPrefixAndRules(rName, source, cacheControl string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_directory_sync" "test" {
+  bucket        = aws_s3_bucket.test.bucket
+  key_prefix    = "site/"
+  source        = %[2]q
+  cache_control = "no-cache"
+
+  rule {
+    pattern       = "assets/*"
+    cache_control = %[3]q
+  }
+
+  rule {
+    pattern      = "*.wasm"
+    content_type = "application/wasm"
+
+    metadata = {
+      compiled = "true"
+    }
+  }
+}
+`, rName, source, cacheControl)
+}
+
+func testAccDirectorySyncConfig_preExistingObjects(rName, source string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_object" "unrelated" {
+  bucket  = aws_s3_bucket.test.bucket
+  key     = "unrelated.txt"
+  content = "not managed by the directory sync"
+}
+
+resource "aws_s3_object" "log" {
+  bucket  = aws_s3_bucket.test.bucket
+  key     = "logs/access.log"
+  content = "not managed by the directory sync"
+}
+
+resource "aws_s3_directory_sync" "test" {
+  bucket = aws_s3_bucket.test.bucket
+  source = %[2]q
+
+  depends_on = [aws_s3_object.unrelated, aws_s3_object.log]
+}
+`, rName, source)
+}
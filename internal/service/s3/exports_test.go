@@ -32,6 +32,7 @@ var (
 	BucketRegionalDomainName              = bucketRegionalDomainName
 	BucketWebsiteEndpointAndDomain        = bucketWebsiteEndpointAndDomain
 	DeleteAllObjectVersions               = deleteAllObjectVersions
+	DirectorySyncPatternMatch             = directorySyncPatternMatch
 	EmptyBucket                           = emptyBucket
 	FindAnalyticsConfiguration            = findAnalyticsConfiguration
 	FindBucket                            = findBucket
@@ -49,6 +50,7 @@ var (
 	FindLoggingEnabled                    = findLoggingEnabled
 	FindMetricsConfiguration              = findMetricsConfiguration
 	FindObjectByBucketAndKey              = findObjectByBucketAndKey
+	FindObjectKeysByPrefix                = findObjectKeysByPrefix
 	FindObjectLockConfiguration           = findObjectLockConfiguration
 	FindOwnershipControls                 = findOwnershipControls
 	FindPublicAccessBlockConfiguration    = findPublicAccessBlockConfiguration
@@ -78,6 +80,9 @@ var (
 	DirectoryBucketNameRegex = directoryBucketNameRegex
 
 	LifecycleConfigEqual = lifecycleConfigEqual
+
+	CompositeObjectChecksum = compositeObjectChecksum
+	FullObjectChecksum      = fullObjectChecksum
 )
 
 type (
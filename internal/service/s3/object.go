@@ -177,6 +177,27 @@ func resourceObject() *schema.Resource {
 				Elem:         &schema.Schema{Type: schema.TypeString},
 				ValidateFunc: validateMetadataIsLowerCase,
 			},
+			"multipart_upload": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"concurrency": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      manager.DefaultUploadConcurrency,
+							ValidateFunc: validation.IntBetween(1, 64),
+						},
+						"part_size": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      manager.DefaultUploadPartSize / mebibyte,
+							ValidateFunc: validation.IntBetween(5, 5120),
+						},
+					},
+				},
+			},
 			"object_lock_legal_hold_status": {
 				Type:             schema.TypeString,
 				Optional:         true,
@@ -593,10 +614,45 @@ func resourceObjectUpload(ctx context.Context, d *schema.ResourceData, meta any)
 		input.ChecksumAlgorithm = types.ChecksumAlgorithmCrc32
 	}
 
-	uploader := manager.NewUploader(conn, manager.WithUploaderRequestOptions(optFns...))
+	opts := defaultObjectMultipartUploadOptions()
+	if v, ok := d.GetOk("multipart_upload"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
+		opts = expandObjectMultipartUploadOptions(v.([]any)[0].(map[string]any))
+	}
 
-	if _, err := uploader.Upload(ctx, input); err != nil {
-		return sdkdiag.AppendErrorf(diags, "uploading S3 Object (%s) to Bucket (%s): %s", aws.ToString(input.Key), aws.ToString(input.Bucket), err)
+	partSize, uploaded := opts.partSize, false
+	if file, ok := body.(*os.File); ok {
+		fi, err := file.Stat()
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading S3 object source (%s): %s", file.Name(), err)
+		}
+
+		if size := fi.Size(); size > opts.partSize {
+			// Large files are uploaded using a resumable multipart upload.
+			partSize = opts.effectivePartSize(size)
+
+			if err := uploadObjectMultipart(ctx, conn, input, file, size, opts, optFns...); err != nil {
+				return sdkdiag.AppendErrorf(diags, "uploading S3 Object (%s) to Bucket (%s): %s", aws.ToString(input.Key), aws.ToString(input.Bucket), err)
+			}
+
+			uploaded = true
+		}
+	}
+
+	if !uploaded {
+		uploader := manager.NewUploader(conn, manager.WithUploaderRequestOptions(optFns...), func(u *manager.Uploader) {
+			u.Concurrency = opts.concurrency
+			u.PartSize = opts.partSize
+		})
+
+		if _, err := uploader.Upload(ctx, input); err != nil {
+			return sdkdiag.AppendErrorf(diags, "uploading S3 Object (%s) to Bucket (%s): %s", aws.ToString(input.Key), aws.ToString(input.Bucket), err)
+		}
+	}
+
+	if input.ChecksumAlgorithm != "" {
+		if err := verifyObjectChecksum(ctx, conn, aws.ToString(input.Bucket), aws.ToString(input.Key), input.ChecksumAlgorithm, body, partSize, optFns...); err != nil {
+			return sdkdiag.AppendErrorf(diags, "verifying S3 Object (%s) checksum: %s", aws.ToString(input.Key), err)
+		}
 	}
 
 	if d.IsNewResource() {
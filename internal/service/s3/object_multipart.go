@@ -0,0 +1,505 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"context"
+	"crypto/md5" // nosemgrep:ci.go-crypto-md5 -- S3 part ETags are MD5 digests.
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"hash/crc32"
+	"hash/crc64"
+	"io"
+	"log"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/s3/manager"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	objectMultipartUploadMaxParts = 10000
+	mebibyte                      = 1024 * 1024
+)
+
+// objectMultipartUploadOptions controls how large objects are uploaded.
+type objectMultipartUploadOptions struct {
+	concurrency int
+	partSize    int64
+}
+
+func defaultObjectMultipartUploadOptions() objectMultipartUploadOptions {
+	return objectMultipartUploadOptions{
+		concurrency: manager.DefaultUploadConcurrency,
+		partSize:    manager.DefaultUploadPartSize,
+	}
+}
+
+func expandObjectMultipartUploadOptions(tfMap map[string]any) objectMultipartUploadOptions {
+	opts := defaultObjectMultipartUploadOptions()
+
+	if v, ok := tfMap["concurrency"].(int); ok && v > 0 {
+		opts.concurrency = v
+	}
+
+	if v, ok := tfMap["part_size"].(int); ok && v > 0 {
+		opts.partSize = int64(v) * mebibyte
+	}
+
+	return opts
+}
+
+// effectivePartSize returns the part size used to upload an object of the specified size,
+// increasing the configured part size if necessary to stay within the maximum number of parts.
+func (o objectMultipartUploadOptions) effectivePartSize(size int64) int64 {
+	partSize := o.partSize
+
+	if size/partSize >= objectMultipartUploadMaxParts {
+		partSize = (size/objectMultipartUploadMaxParts + mebibyte) / mebibyte * mebibyte
+	}
+
+	return partSize
+}
+
+// uploadObjectMultipart uploads the specified object body using S3 multipart upload.
+// If an incomplete multipart upload of the same key exists, for example from a previous attempt that failed,
+// parts whose size and content match are reused rather than uploaded again.
+// If the upload fails the incomplete multipart upload is left in place so that a retry can resume it.
+func uploadObjectMultipart(ctx context.Context, conn *s3.Client, input *s3.PutObjectInput, body io.ReaderAt, size int64, opts objectMultipartUploadOptions, optFns ...func(*s3.Options)) error {
+	bucket, key := aws.ToString(input.Bucket), aws.ToString(input.Key)
+	partSize := opts.effectivePartSize(size)
+	nParts := int32((size + partSize - 1) / partSize)
+
+	uploadID, existingParts, err := findResumableMultipartUpload(ctx, conn, bucket, key, input.ChecksumAlgorithm, partSize, nParts, optFns...)
+
+	if err != nil {
+		return err
+	}
+
+	if uploadID == "" {
+		input := expandCreateMultipartUploadInput(input)
+
+		output, err := conn.CreateMultipartUpload(ctx, input, optFns...)
+
+		if err != nil {
+			return fmt.Errorf("creating multipart upload: %w", err)
+		}
+
+		uploadID = aws.ToString(output.UploadId)
+	} else {
+		log.Printf("[INFO] Resuming S3 Object (%s) multipart upload (%s) with %d of %d parts already uploaded", key, uploadID, len(existingParts), nParts)
+	}
+
+	var (
+		mu    sync.Mutex
+		errs  []error
+		parts = make([]types.CompletedPart, nParts)
+		sem   = make(chan struct{}, max(opts.concurrency, 1))
+		wg    sync.WaitGroup
+	)
+
+	for i := range nParts {
+		partNumber := i + 1
+		offset := int64(i) * partSize
+		n := min(partSize, size-offset)
+
+		wg.Add(1)
+		sem <- struct{}{}
+
+		go func() {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			part, err := uploadObjectPart(ctx, conn, bucket, key, uploadID, input.ChecksumAlgorithm, partNumber, io.NewSectionReader(body, offset, n), existingParts[partNumber], optFns...)
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			if err != nil {
+				errs = append(errs, err)
+
+				return
+			}
+
+			parts[i] = *part
+		}()
+	}
+
+	wg.Wait()
+
+	if err := errors.Join(errs...); err != nil {
+		return fmt.Errorf("uploading parts of multipart upload (%s): %w", uploadID, err)
+	}
+
+	completeInput := &s3.CompleteMultipartUploadInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+		MultipartUpload: &types.CompletedMultipartUpload{
+			Parts: parts,
+		},
+		UploadId: aws.String(uploadID),
+	}
+	if input.ChecksumAlgorithm == types.ChecksumAlgorithmCrc64nvme {
+		completeInput.ChecksumType = types.ChecksumTypeFullObject
+	}
+
+	if _, err := conn.CompleteMultipartUpload(ctx, completeInput, optFns...); err != nil {
+		return fmt.Errorf("completing multipart upload (%s): %w", uploadID, err)
+	}
+
+	return nil
+}
+
+// uploadObjectPart uploads a single part, unless the existing part has the same content.
+func uploadObjectPart(ctx context.Context, conn *s3.Client, bucket, key, uploadID string, algorithm types.ChecksumAlgorithm, partNumber int32, body *io.SectionReader, existing *types.Part, optFns ...func(*s3.Options)) (*types.CompletedPart, error) {
+	if existing != nil {
+		match, err := objectPartMatches(body, algorithm, existing)
+
+		if err != nil {
+			return nil, fmt.Errorf("reading part %d: %w", partNumber, err)
+		}
+
+		if match {
+			return &types.CompletedPart{
+				ChecksumCRC32:     existing.ChecksumCRC32,
+				ChecksumCRC32C:    existing.ChecksumCRC32C,
+				ChecksumCRC64NVME: existing.ChecksumCRC64NVME,
+				ChecksumSHA1:      existing.ChecksumSHA1,
+				ChecksumSHA256:    existing.ChecksumSHA256,
+				ETag:              existing.ETag,
+				PartNumber:        aws.Int32(partNumber),
+			}, nil
+		}
+
+		if _, err := body.Seek(0, io.SeekStart); err != nil {
+			return nil, err
+		}
+	}
+
+	input := &s3.UploadPartInput{
+		Body:              body,
+		Bucket:            aws.String(bucket),
+		ChecksumAlgorithm: algorithm,
+		ContentLength:     aws.Int64(body.Size()),
+		Key:               aws.String(key),
+		PartNumber:        aws.Int32(partNumber),
+		UploadId:          aws.String(uploadID),
+	}
+
+	output, err := conn.UploadPart(ctx, input, optFns...)
+
+	if err != nil {
+		return nil, fmt.Errorf("uploading part %d: %w", partNumber, err)
+	}
+
+	return &types.CompletedPart{
+		ChecksumCRC32:     output.ChecksumCRC32,
+		ChecksumCRC32C:    output.ChecksumCRC32C,
+		ChecksumCRC64NVME: output.ChecksumCRC64NVME,
+		ChecksumSHA1:      output.ChecksumSHA1,
+		ChecksumSHA256:    output.ChecksumSHA256,
+		ETag:              output.ETag,
+		PartNumber:        aws.Int32(partNumber),
+	}, nil
+}
+
+// objectPartMatches returns whether the content of an already uploaded part matches the local part.
+// The part's checksum is compared if a checksum algorithm is in use, otherwise its ETag is compared to the MD5 digest of the local part.
+func objectPartMatches(body io.Reader, algorithm types.ChecksumAlgorithm, part *types.Part) (bool, error) {
+	if h := newObjectChecksumHash(algorithm); h != nil {
+		if _, err := io.Copy(h, body); err != nil {
+			return false, err
+		}
+
+		return base64.StdEncoding.EncodeToString(h.Sum(nil)) == objectPartChecksum(algorithm, part), nil
+	}
+
+	h := md5.New()
+	if _, err := io.Copy(h, body); err != nil {
+		return false, err
+	}
+
+	return hex.EncodeToString(h.Sum(nil)) == strings.Trim(aws.ToString(part.ETag), `"`), nil
+}
+
+// findResumableMultipartUpload returns the ID and already uploaded parts of the most recently initiated incomplete multipart upload of the specified key.
+// Incomplete uploads that can't be resumed, for example because they use a different part size, are aborted.
+// An empty upload ID is returned if there is no upload to resume.
+func findResumableMultipartUpload(ctx context.Context, conn *s3.Client, bucket, key string, algorithm types.ChecksumAlgorithm, partSize int64, nParts int32, optFns ...func(*s3.Options)) (string, map[int32]*types.Part, error) {
+	upload, err := findLatestMultipartUploadByKey(ctx, conn, bucket, key, optFns...)
+
+	if tfresource.NotFound(err) {
+		return "", nil, nil
+	}
+
+	if err != nil {
+		return "", nil, fmt.Errorf("listing multipart uploads: %w", err)
+	}
+
+	uploadID := aws.ToString(upload.UploadId)
+	resumable := upload.ChecksumAlgorithm == algorithm
+
+	parts := make(map[int32]*types.Part)
+	if resumable {
+		input := &s3.ListPartsInput{
+			Bucket:   aws.String(bucket),
+			Key:      aws.String(key),
+			UploadId: aws.String(uploadID),
+		}
+
+		pages := s3.NewListPartsPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx, optFns...)
+
+			if err != nil {
+				return "", nil, fmt.Errorf("listing parts of multipart upload (%s): %w", uploadID, err)
+			}
+
+			for _, v := range page.Parts {
+				partNumber := aws.ToInt32(v.PartNumber)
+
+				// Every part except the last must be exactly the part size.
+				if partNumber > nParts || (partNumber < nParts && aws.ToInt64(v.Size) != partSize) {
+					resumable = false
+					break
+				}
+
+				parts[partNumber] = &v
+			}
+
+			if !resumable {
+				break
+			}
+		}
+	}
+
+	if !resumable {
+		log.Printf("[INFO] Aborting S3 Object (%s) multipart upload (%s)", key, uploadID)
+		input := &s3.AbortMultipartUploadInput{
+			Bucket:   aws.String(bucket),
+			Key:      aws.String(key),
+			UploadId: aws.String(uploadID),
+		}
+
+		if _, err := conn.AbortMultipartUpload(ctx, input, optFns...); err != nil {
+			return "", nil, fmt.Errorf("aborting multipart upload (%s): %w", uploadID, err)
+		}
+
+		return "", nil, nil
+	}
+
+	return uploadID, parts, nil
+}
+
+func findLatestMultipartUploadByKey(ctx context.Context, conn *s3.Client, bucket, key string, optFns ...func(*s3.Options)) (*types.MultipartUpload, error) {
+	input := &s3.ListMultipartUploadsInput{
+		Bucket: aws.String(bucket),
+		Prefix: aws.String(key),
+	}
+	var output []types.MultipartUpload
+
+	pages := s3.NewListMultipartUploadsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx, optFns...)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Uploads {
+			if aws.ToString(v.Key) == key {
+				output = append(output, v)
+			}
+		}
+	}
+
+	if len(output) == 0 {
+		return nil, &retry.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	upload := slices.MaxFunc(output, func(a, b types.MultipartUpload) int {
+		return aws.ToTime(a.Initiated).Compare(aws.ToTime(b.Initiated))
+	})
+
+	return &upload, nil
+}
+
+func expandCreateMultipartUploadInput(input *s3.PutObjectInput) *s3.CreateMultipartUploadInput {
+	apiObject := &s3.CreateMultipartUploadInput{
+		ACL:                       input.ACL,
+		Bucket:                    input.Bucket,
+		BucketKeyEnabled:          input.BucketKeyEnabled,
+		CacheControl:              input.CacheControl,
+		ChecksumAlgorithm:         input.ChecksumAlgorithm,
+		ContentDisposition:        input.ContentDisposition,
+		ContentEncoding:           input.ContentEncoding,
+		ContentLanguage:           input.ContentLanguage,
+		ContentType:               input.ContentType,
+		Key:                       input.Key,
+		Metadata:                  input.Metadata,
+		ObjectLockLegalHoldStatus: input.ObjectLockLegalHoldStatus,
+		ObjectLockMode:            input.ObjectLockMode,
+		ObjectLockRetainUntilDate: input.ObjectLockRetainUntilDate,
+		SSEKMSKeyId:               input.SSEKMSKeyId,
+		ServerSideEncryption:      input.ServerSideEncryption,
+		StorageClass:              input.StorageClass,
+		Tagging:                   input.Tagging,
+		WebsiteRedirectLocation:   input.WebsiteRedirectLocation,
+	}
+
+	if input.ChecksumAlgorithm == types.ChecksumAlgorithmCrc64nvme {
+		apiObject.ChecksumType = types.ChecksumTypeFullObject
+	}
+
+	return apiObject
+}
+
+// verifyObjectChecksum verifies that the checksum returned by S3 for the uploaded object matches the checksum of the local content.
+// Composite checksums of multipart uploads, in the form "<checksum>-<number of parts>", are computed from the checksums of each part.
+func verifyObjectChecksum(ctx context.Context, conn *s3.Client, bucket, key string, algorithm types.ChecksumAlgorithm, body io.ReadSeeker, partSize int64, optFns ...func(*s3.Options)) error {
+	if newObjectChecksumHash(algorithm) == nil {
+		return nil
+	}
+
+	output, err := findObjectByBucketAndKey(ctx, conn, bucket, key, "", string(algorithm), optFns...)
+
+	if err != nil {
+		return err
+	}
+
+	want := objectChecksum(algorithm, output)
+	if want == "" {
+		return nil
+	}
+
+	if _, err := body.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+
+	var got string
+	if _, suffix, ok := strings.Cut(want, "-"); ok {
+		checksum, err := compositeObjectChecksum(algorithm, body, partSize)
+		if err != nil {
+			return err
+		}
+
+		got = checksum + "-" + suffix
+	} else {
+		got, err = fullObjectChecksum(algorithm, body)
+		if err != nil {
+			return err
+		}
+	}
+
+	if got != want {
+		return fmt.Errorf("%s checksum mismatch: local %s, S3 %s", algorithm, got, want)
+	}
+
+	return nil
+}
+
+// fullObjectChecksum returns the checksum of the entire content.
+func fullObjectChecksum(algorithm types.ChecksumAlgorithm, body io.Reader) (string, error) {
+	h := newObjectChecksumHash(algorithm)
+
+	if _, err := io.Copy(h, body); err != nil {
+		return "", err
+	}
+
+	return base64.StdEncoding.EncodeToString(h.Sum(nil)), nil
+}
+
+// compositeObjectChecksum returns the checksum of the concatenated checksums of each part.
+func compositeObjectChecksum(algorithm types.ChecksumAlgorithm, body io.Reader, partSize int64) (string, error) {
+	composite := newObjectChecksumHash(algorithm)
+
+	for {
+		h := newObjectChecksumHash(algorithm)
+		n, err := io.CopyN(h, body, partSize)
+
+		if n > 0 {
+			composite.Write(h.Sum(nil))
+		}
+
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return "", err
+		}
+	}
+
+	return base64.StdEncoding.EncodeToString(composite.Sum(nil)), nil
+}
+
+// crc64NVMETable is the table for the CRC-64/NVME polynomial (reversed).
+var crc64NVMETable = crc64.MakeTable(0x9a6c9329ac4bc9b5)
+
+// newObjectChecksumHash returns a new hash for the specified checksum algorithm, or nil if the algorithm is not supported.
+func newObjectChecksumHash(algorithm types.ChecksumAlgorithm) hash.Hash {
+	switch algorithm {
+	case types.ChecksumAlgorithmCrc32:
+		return crc32.NewIEEE()
+	case types.ChecksumAlgorithmCrc32c:
+		return crc32.New(crc32.MakeTable(crc32.Castagnoli))
+	case types.ChecksumAlgorithmCrc64nvme:
+		return crc64.New(crc64NVMETable)
+	case types.ChecksumAlgorithmSha1:
+		return sha1.New()
+	case types.ChecksumAlgorithmSha256:
+		return sha256.New()
+	default:
+		return nil
+	}
+}
+
+func objectChecksum(algorithm types.ChecksumAlgorithm, output *s3.HeadObjectOutput) string {
+	switch algorithm {
+	case types.ChecksumAlgorithmCrc32:
+		return aws.ToString(output.ChecksumCRC32)
+	case types.ChecksumAlgorithmCrc32c:
+		return aws.ToString(output.ChecksumCRC32C)
+	case types.ChecksumAlgorithmCrc64nvme:
+		return aws.ToString(output.ChecksumCRC64NVME)
+	case types.ChecksumAlgorithmSha1:
+		return aws.ToString(output.ChecksumSHA1)
+	case types.ChecksumAlgorithmSha256:
+		return aws.ToString(output.ChecksumSHA256)
+	default:
+		return ""
+	}
+}
+
+func objectPartChecksum(algorithm types.ChecksumAlgorithm, part *types.Part) string {
+	switch algorithm {
+	case types.ChecksumAlgorithmCrc32:
+		return aws.ToString(part.ChecksumCRC32)
+	case types.ChecksumAlgorithmCrc32c:
+		return aws.ToString(part.ChecksumCRC32C)
+	case types.ChecksumAlgorithmCrc64nvme:
+		return aws.ToString(part.ChecksumCRC64NVME)
+	case types.ChecksumAlgorithmSha1:
+		return aws.ToString(part.ChecksumSHA1)
+	case types.ChecksumAlgorithmSha256:
+		return aws.ToString(part.ChecksumSHA256)
+	default:
+		return ""
+	}
+}
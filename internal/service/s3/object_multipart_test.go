@@ -0,0 +1,92 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	tfs3 "github.com/hashicorp/terraform-provider-aws/internal/service/s3"
+)
+
+func TestFullObjectChecksum(t *testing.T) {
+	t.Parallel()
+
+	const body = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+
+	testCases := []struct {
+		algorithm types.ChecksumAlgorithm
+		want      string
+	}{
+		{
+			algorithm: types.ChecksumAlgorithmCrc32,
+			want:      "q/d4Ig==",
+		},
+		{
+			algorithm: types.ChecksumAlgorithmCrc64nvme,
+			want:      "easTZmYRIl8=",
+		},
+		{
+			algorithm: types.ChecksumAlgorithmSha256,
+			want:      "1uxomN6H3axuWzYRcIp6ocLSmCkzScwabCmaHbcUnTg=",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(string(testCase.algorithm), func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tfs3.FullObjectChecksum(testCase.algorithm, strings.NewReader(body))
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.want {
+				t.Errorf("FullObjectChecksum(%s) = %q, want %q", testCase.algorithm, got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestCompositeObjectChecksum(t *testing.T) {
+	t.Parallel()
+
+	const body = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+
+	testCases := []struct {
+		algorithm types.ChecksumAlgorithm
+		want      string
+	}{
+		{
+			algorithm: types.ChecksumAlgorithmCrc32,
+			want:      "UUykBg==",
+		},
+		{
+			algorithm: types.ChecksumAlgorithmCrc32c,
+			want:      "aCKo3g==",
+		},
+		{
+			algorithm: types.ChecksumAlgorithmSha256,
+			want:      "k/qlrjzKwK465GQDELj4xIbqNg66wYAv9w97ExcfXWk=",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(string(testCase.algorithm), func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tfs3.CompositeObjectChecksum(testCase.algorithm, strings.NewReader(body), 10)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.want {
+				t.Errorf("CompositeObjectChecksum(%s) = %q, want %q", testCase.algorithm, got, testCase.want)
+			}
+		})
+	}
+}
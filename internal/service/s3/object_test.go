@@ -11,6 +11,7 @@ import (
 	"io"
 	"os"
 	"slices"
+	"strings"
 	"testing"
 	"time"
 
@@ -1577,6 +1578,51 @@ func TestAccS3Object_ignoreTags(t *testing.T) {
 	})
 }
 
+func TestAccS3Object_multipartUpload(t *testing.T) {
+	ctx := acctest.Context(t)
+	var obj s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	// 12 MiB results in 3 parts of at most 5 MiB.
+	source := testAccObjectCreateTempFile(t, strings.Repeat("ABCDEFGHIJKLMNOP", 12*1024*1024/16))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectConfig_multipartUpload(rName, source, "CRC32C"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					resource.TestCheckResourceAttr(resourceName, "checksum_algorithm", "CRC32C"),
+					resource.TestMatchResourceAttr(resourceName, "checksum_crc32c", regexache.MustCompile(`-3$`)),
+					resource.TestCheckResourceAttr(resourceName, "multipart_upload.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "multipart_upload.0.concurrency", "2"),
+					resource.TestCheckResourceAttr(resourceName, "multipart_upload.0.part_size", "5"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"checksum_algorithm", "checksum_crc32c", names.AttrForceDestroy, "multipart_upload", names.AttrSource},
+				ImportStateIdFunc:       testAccObjectImportStateIdFunc(resourceName),
+			},
+			{
+				Config: testAccObjectConfig_multipartUpload(rName, source, "SHA256"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					resource.TestCheckResourceAttr(resourceName, "checksum_algorithm", "SHA256"),
+					resource.TestCheckResourceAttr(resourceName, "checksum_crc32c", ""),
+					resource.TestMatchResourceAttr(resourceName, "checksum_sha256", regexache.MustCompile(`-3$`)),
+				),
+			},
+		},
+	})
+}
+
 func TestAccS3Object_checksumAlgorithm(t *testing.T) {
 	ctx := acctest.Context(t)
 	var obj s3.GetObjectOutput
@@ -3092,6 +3138,27 @@ resource "aws_s3_object" "object" {
 `, rName, checksumAlgorithm)
 }
 
+func testAccObjectConfig_multipartUpload(rName, source, checksumAlgorithm string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_object" "object" {
+  bucket = aws_s3_bucket.test.bucket
+  key    = "test-key"
+  source = %[2]q
+
+  checksum_algorithm = %[3]q
+
+  multipart_upload {
+    concurrency = 2
+    part_size   = 5
+  }
+}
+`, rName, source, checksumAlgorithm)
+}
+
 func testAccObjectConfig_keyWithSlashes(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "test" {
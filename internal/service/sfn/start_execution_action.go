@@ -0,0 +1,193 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sfn
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sfn"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/sfn/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @Action("aws_sfn_start_execution", name="Start Execution")
+func newStartExecutionAction(context.Context) (action.ActionWithConfigure, error) {
+	return &startExecutionAction{}, nil
+}
+
+type startExecutionAction struct {
+	framework.ActionWithConfigure
+}
+
+func (a *startExecutionAction) Schema(ctx context.Context, request action.SchemaRequest, response *action.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"input": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					validators.JSON(),
+				},
+			},
+			names.AttrName: schema.StringAttribute{
+				Optional: true,
+			},
+			"state_machine_arn": schema.StringAttribute{
+				Required: true,
+			},
+			names.AttrTimeout: schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.Between(60, 86400),
+				},
+			},
+			"wait_for_completion": schema.BoolAttribute{
+				Optional: true,
+			},
+		},
+	}
+}
+
+func (a *startExecutionAction) Invoke(ctx context.Context, request action.InvokeRequest, response *action.InvokeResponse) {
+	var data startExecutionActionModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := a.Meta().SFNClient(ctx)
+
+	stateMachineARN := fwflex.StringValueFromFramework(ctx, data.StateMachineARN)
+	input := sfn.StartExecutionInput{
+		Input:           fwflex.StringFromFramework(ctx, data.Input),
+		Name:            fwflex.StringFromFramework(ctx, data.Name),
+		StateMachineArn: aws.String(stateMachineARN),
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Starting Step Functions State Machine %s execution...", stateMachineARN),
+	})
+
+	output, err := conn.StartExecution(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("starting Step Functions State Machine (%s) execution", stateMachineARN), err.Error())
+
+		return
+	}
+
+	executionARN := aws.ToString(output.ExecutionArn)
+
+	if !data.WaitForCompletion.IsNull() && !data.WaitForCompletion.ValueBool() {
+		response.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("Step Functions execution %s started", executionARN),
+		})
+
+		return
+	}
+
+	timeout := 30 * time.Minute
+	if !data.Timeout.IsNull() {
+		timeout = time.Duration(data.Timeout.ValueInt64()) * time.Second
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Waiting for Step Functions execution %s to complete...", executionARN),
+	})
+
+	if _, err := waitExecutionSucceeded(ctx, conn, executionARN, timeout); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Step Functions execution (%s) complete", executionARN), err.Error())
+
+		return
+	}
+
+	response.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Step Functions execution %s succeeded", executionARN),
+	})
+}
+
+type startExecutionActionModel struct {
+	Input             types.String `tfsdk:"input"`
+	Name              types.String `tfsdk:"name"`
+	StateMachineARN   types.String `tfsdk:"state_machine_arn"`
+	Timeout           types.Int64  `tfsdk:"timeout"`
+	WaitForCompletion types.Bool   `tfsdk:"wait_for_completion"`
+}
+
+func findExecutionByARN(ctx context.Context, conn *sfn.Client, arn string) (*sfn.DescribeExecutionOutput, error) {
+	input := sfn.DescribeExecutionInput{
+		ExecutionArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeExecution(ctx, &input)
+
+	if errs.IsA[*awstypes.ExecutionDoesNotExist](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusExecution(ctx context.Context, conn *sfn.Client, arn string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, err := findExecutionByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitExecutionSucceeded(ctx context.Context, conn *sfn.Client, arn string, timeout time.Duration) (*sfn.DescribeExecutionOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.ExecutionStatusRunning),
+		Target:     enum.Slice(awstypes.ExecutionStatusSucceeded),
+		Refresh:    statusExecution(ctx, conn, arn),
+		Timeout:    timeout,
+		MinTimeout: 5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*sfn.DescribeExecutionOutput); ok {
+		if output.Error != nil {
+			tfresource.SetLastError(err, fmt.Errorf("%s: %s", aws.ToString(output.Error), aws.ToString(output.Cause)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,349 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package workspacesweb
+
+import (
+	"context"
+	"encoding/pem"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/workspacesweb"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/workspacesweb/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfmaps "github.com/hashicorp/terraform-provider-aws/internal/maps"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_workspacesweb_trust_store", name="Trust Store")
+// @Tags(identifierAttribute="trust_store_arn")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/workspacesweb/types;awstypes;awstypes.TrustStore")
+// @Testing(importStateIdAttribute="trust_store_arn")
+func newTrustStoreResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &trustStoreResource{}
+
+	return r, nil
+}
+
+type trustStoreResource struct {
+	framework.ResourceWithConfigure
+}
+
+func (r *trustStoreResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"associated_portal_arns": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Computed:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"certificate_list": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"trust_store_arn": framework.ARNAttributeComputedOnly(),
+		},
+	}
+}
+
+func (r *trustStoreResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data trustStoreResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkSpacesWebClient(ctx)
+
+	input := workspacesweb.CreateTrustStoreInput{
+		CertificateList: expandCertificates(fwflex.ExpandFrameworkStringValueSet(ctx, data.CertificateList)),
+		Tags:            getTagsIn(ctx),
+	}
+
+	output, err := conn.CreateTrustStore(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError("creating WorkSpaces Web Trust Store", err.Error())
+
+		return
+	}
+
+	arn := aws.ToString(output.TrustStoreArn)
+	trustStore, err := findTrustStoreByARN(ctx, conn, arn)
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root("trust_store_arn"), arn) // Set 'trust_store_arn' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("reading WorkSpaces Web Trust Store (%s)", arn), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.AssociatedPortalARNs = fwflex.FlattenFrameworkStringValueListOfString(ctx, trustStore.AssociatedPortalArns)
+	data.TrustStoreARN = types.StringValue(arn)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *trustStoreResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data trustStoreResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkSpacesWebClient(ctx)
+
+	arn := data.TrustStoreARN.ValueString()
+	output, err := findTrustStoreByARN(ctx, conn, arn)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading WorkSpaces Web Trust Store (%s)", arn), err.Error())
+
+		return
+	}
+
+	certificates, err := findTrustStoreCertificatesByARN(ctx, conn, arn)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading WorkSpaces Web Trust Store (%s) certificates", arn), err.Error())
+
+		return
+	}
+
+	// Keep the configured representation of certificates that are unchanged.
+	configured := make(map[string]string)
+	for _, v := range fwflex.ExpandFrameworkStringValueSet(ctx, data.CertificateList) {
+		configured[normalizeCertificate(v)] = v
+	}
+	for thumbprint, body := range certificates {
+		if v, ok := configured[body]; ok {
+			certificates[thumbprint] = v
+		}
+	}
+
+	data.AssociatedPortalARNs = fwflex.FlattenFrameworkStringValueListOfString(ctx, output.AssociatedPortalArns)
+	response.Diagnostics.Append(fwflex.Flatten(ctx, tfmaps.Values(certificates), &data.CertificateList)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *trustStoreResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new, old trustStoreResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkSpacesWebClient(ctx)
+
+	arn := new.TrustStoreARN.ValueString()
+
+	if !new.CertificateList.Equal(old.CertificateList) {
+		certificates, err := findTrustStoreCertificatesByARN(ctx, conn, arn)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("reading WorkSpaces Web Trust Store (%s) certificates", arn), err.Error())
+
+			return
+		}
+
+		want := make(map[string]struct{})
+		for _, v := range fwflex.ExpandFrameworkStringValueSet(ctx, new.CertificateList) {
+			want[normalizeCertificate(v)] = struct{}{}
+		}
+
+		input := workspacesweb.UpdateTrustStoreInput{
+			TrustStoreArn: aws.String(arn),
+		}
+		for thumbprint, body := range certificates {
+			if _, ok := want[body]; ok {
+				delete(want, body)
+			} else {
+				input.CertificatesToDelete = append(input.CertificatesToDelete, thumbprint)
+			}
+		}
+		for body := range want {
+			input.CertificatesToAdd = append(input.CertificatesToAdd, []byte(body))
+		}
+
+		_, err = conn.UpdateTrustStore(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating WorkSpaces Web Trust Store (%s)", arn), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *trustStoreResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data trustStoreResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WorkSpacesWebClient(ctx)
+
+	arn := data.TrustStoreARN.ValueString()
+	input := workspacesweb.DeleteTrustStoreInput{
+		TrustStoreArn: aws.String(arn),
+	}
+	_, err := conn.DeleteTrustStore(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting WorkSpaces Web Trust Store (%s)", arn), err.Error())
+
+		return
+	}
+}
+
+func (r *trustStoreResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("trust_store_arn"), request, response)
+}
+
+func findTrustStoreByARN(ctx context.Context, conn *workspacesweb.Client, arn string) (*awstypes.TrustStore, error) {
+	input := workspacesweb.GetTrustStoreInput{
+		TrustStoreArn: aws.String(arn),
+	}
+	output, err := conn.GetTrustStore(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.TrustStore == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.TrustStore, nil
+}
+
+// findTrustStoreCertificatesByARN returns the trust store's PEM-encoded certificates keyed by thumbprint.
+func findTrustStoreCertificatesByARN(ctx context.Context, conn *workspacesweb.Client, arn string) (map[string]string, error) {
+	input := workspacesweb.ListTrustStoreCertificatesInput{
+		TrustStoreArn: aws.String(arn),
+	}
+	output := make(map[string]string)
+
+	pages := workspacesweb.NewListTrustStoreCertificatesPaginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.CertificateList {
+			thumbprint := aws.ToString(v.Thumbprint)
+			input := workspacesweb.GetTrustStoreCertificateInput{
+				Thumbprint:    aws.String(thumbprint),
+				TrustStoreArn: aws.String(arn),
+			}
+			certificate, err := conn.GetTrustStoreCertificate(ctx, &input)
+
+			if err != nil {
+				return nil, err
+			}
+
+			if certificate == nil || certificate.Certificate == nil {
+				return nil, tfresource.NewEmptyResultError(input)
+			}
+
+			output[thumbprint] = normalizeCertificate(string(certificate.Certificate.Body))
+		}
+	}
+
+	return output, nil
+}
+
+func expandCertificates(tfList []string) [][]byte {
+	apiObjects := make([][]byte, 0, len(tfList))
+
+	for _, v := range tfList {
+		apiObjects = append(apiObjects, []byte(normalizeCertificate(v)))
+	}
+
+	return apiObjects
+}
+
+// normalizeCertificate returns the certificate PEM-encoded, re-encoding
+// DER bodies and trimming surrounding whitespace so that comparisons are stable.
+func normalizeCertificate(v string) string {
+	v = strings.TrimSpace(v)
+
+	if block, _ := pem.Decode([]byte(v)); block == nil {
+		v = string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: []byte(v)}))
+	}
+
+	return strings.TrimSpace(v)
+}
+
+type trustStoreResourceModel struct {
+	AssociatedPortalARNs fwtypes.ListOfString `tfsdk:"associated_portal_arns"`
+	CertificateList      fwtypes.SetOfString  `tfsdk:"certificate_list"`
+	Tags                 tftags.Map           `tfsdk:"tags"`
+	TagsAll              tftags.Map           `tfsdk:"tags_all"`
+	TrustStoreARN        types.String         `tfsdk:"trust_store_arn"`
+}
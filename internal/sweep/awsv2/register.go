@@ -13,6 +13,8 @@ import (
 )
 
 func Register(name string, f sweep.SweeperFn, dependencies ...string) {
+	sweep.RegisterLister(name, f)
+
 	resource.AddTestSweepers(name, &resource.Sweeper{
 		Name: name,
 		F: func(region string) error {
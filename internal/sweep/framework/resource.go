@@ -5,6 +5,7 @@ package framework
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
@@ -14,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 type attribute struct {
@@ -77,6 +79,56 @@ func (sr *sweepResource) Delete(ctx context.Context, optFns ...tfresource.Option
 	return deleteResource(ctx, state, resource)
 }
 
+func (sr *sweepResource) ImportID(context.Context) string {
+	for _, attr := range sr.attributes {
+		if attr.path == names.AttrID {
+			return fmt.Sprint(attr.value)
+		}
+	}
+
+	if len(sr.attributes) > 0 {
+		return fmt.Sprint(sr.attributes[0].value)
+	}
+
+	return ""
+}
+
+func (sr *sweepResource) RequiredAttributes(ctx context.Context) (map[string]any, error) {
+	resource, err := sr.factory(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var schemaResp fwresource.SchemaResponse
+	resource.Schema(ctx, fwresource.SchemaRequest{}, &schemaResp)
+	if schemaResp.Diagnostics.HasError() {
+		return nil, fwdiag.DiagnosticsError(schemaResp.Diagnostics)
+	}
+
+	attributes := make(map[string]any)
+
+	for k, v := range schemaResp.Schema.Attributes {
+		if !v.IsRequired() {
+			continue
+		}
+
+		attributes[k] = nil
+
+		for _, attr := range sr.attributes {
+			if attr.path != k {
+				continue
+			}
+
+			switch attr.value.(type) {
+			case bool, float64, int64, string:
+				attributes[k] = attr.value
+			}
+		}
+	}
+
+	return attributes, nil
+}
+
 func deleteResource(ctx context.Context, state tfsdk.State, resource fwresource.Resource) error {
 	var response fwresource.DeleteResponse
 	resource.Delete(ctx, fwresource.DeleteRequest{State: state}, &response)
@@ -0,0 +1,52 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"sync"
+)
+
+// Importable is implemented by Sweepables that can identify the listed resource for import into Terraform.
+type Importable interface {
+	// ImportID returns the identifier used to import the resource.
+	ImportID(ctx context.Context) string
+	// RequiredAttributes returns the resource's required top-level primitive attributes.
+	// The value is nil if not known from listing the resource.
+	RequiredAttributes(ctx context.Context) (map[string]any, error)
+}
+
+var (
+	listers   = make(map[string]SweeperFn)
+	listersMu sync.Mutex
+)
+
+// RegisterLister records the sweeper listing function for the specified resource type
+// so that it can be used outside of sweeper runs, e.g. to build a resource inventory.
+func RegisterLister(name string, f SweeperFn) {
+	listersMu.Lock()
+	defer listersMu.Unlock()
+
+	listers[name] = f
+}
+
+// Lister returns the sweeper listing function registered for the specified resource type.
+func Lister(name string) (SweeperFn, bool) {
+	listersMu.Lock()
+	defer listersMu.Unlock()
+
+	f, ok := listers[name]
+
+	return f, ok
+}
+
+// ListerNames returns the sorted resource types with registered sweeper listing functions.
+func ListerNames() []string {
+	listersMu.Lock()
+	defer listersMu.Unlock()
+
+	return slices.Sorted(maps.Keys(listers))
+}
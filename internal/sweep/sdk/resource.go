@@ -39,6 +39,31 @@ func (sr *sweepResource) Delete(ctx context.Context, optFns ...tfresource.Option
 	return deleteResource(ctx, sr.resource, sr.d, sr.meta)
 }
 
+func (sr *sweepResource) ImportID(context.Context) string {
+	return sr.d.Id()
+}
+
+func (sr *sweepResource) RequiredAttributes(context.Context) (map[string]any, error) {
+	attributes := make(map[string]any)
+
+	for k, v := range sr.resource.SchemaMap() {
+		if !v.Required {
+			continue
+		}
+
+		attributes[k] = nil
+
+		switch v.Type {
+		case schema.TypeBool, schema.TypeFloat, schema.TypeInt, schema.TypeString:
+			if v, ok := sr.d.GetOk(k); ok {
+				attributes[k] = v
+			}
+		}
+	}
+
+	return attributes, nil
+}
+
 type readerSweepResource struct {
 	sweepResource
 }
@@ -14,6 +14,11 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/version"
 )
 
+// subcommands are the additional commands supported by the provider binary, keyed by name.
+// Subcommands are only registered in builds with the corresponding build tag so that
+// the code they depend on (e.g. sweepers) is not linked into the released provider binary.
+var subcommands = make(map[string]func(ctx context.Context, args []string) error)
+
 func main() {
 	debugFlag := flag.Bool("debug", false, "Start provider in debug mode.")
 	flag.Parse()
@@ -22,6 +27,14 @@ func main() {
 	logFlags = logFlags &^ (log.Ldate | log.Ltime)
 	log.SetFlags(logFlags)
 
+	if f, ok := subcommands[flag.Arg(0)]; ok {
+		if err := f(context.Background(), flag.Args()[1:]); err != nil {
+			log.Fatal(err)
+		}
+
+		return
+	}
+
 	if buildInfo, ok := debug.ReadBuildInfo(); ok {
 		log.Printf("Starting %s@%s (%s)...", buildInfo.Main.Path, version.ProviderVersion, buildInfo.GoVersion)
 	}
@@ -0,0 +1,19 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build inventory
+
+package main
+
+import (
+	"context"
+	"os"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/inventory"
+)
+
+func init() {
+	subcommands[inventory.CommandName] = func(ctx context.Context, args []string) error {
+		return inventory.Run(ctx, args, os.Stdout, os.Stderr)
+	}
+}